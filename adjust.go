@@ -0,0 +1,65 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	endpointPaymentCreditAdjustment = "/v1/payments/credit/%s/adjustment"
+)
+
+type Adjustment struct {
+	Amount         float64  `json:"amount"`
+	Currency       Currency `json:"currency"`
+	SoftDescriptor string   `json:"soft_descriptor,omitempty"`
+	DynamicMCC     int      `json:"dynamic_mcc,omitempty"`
+}
+
+func (a Adjustment) MarshalJSON() ([]byte, error) {
+	type Alias Adjustment
+	return json.Marshal(&struct {
+		Alias
+		Amount         int    `json:"amount"`
+		SoftDescriptor string `json:"soft_descriptor,omitempty"`
+	}{
+		Alias:          (Alias)(a),
+		Amount:         toCents(a.Amount),
+		SoftDescriptor: maxLength(a.SoftDescriptor, 22),
+	})
+}
+
+func (p PaymentResponse) Adjust(c ClientCredentials, amount float64) (PaymentResponse, error) {
+	return p.AdjustContext(context.Background(), c, amount)
+}
+
+func (p PaymentResponse) AdjustContext(ctx context.Context, c ClientCredentials, amount float64) (PaymentResponse, error) {
+	return p.AdjustWithContext(ctx, c, Adjustment{Amount: amount})
+}
+
+func (p PaymentResponse) AdjustWith(c ClientCredentials, a Adjustment) (PaymentResponse, error) {
+	return p.AdjustWithContext(context.Background(), c, a)
+}
+
+func (p PaymentResponse) AdjustWithContext(ctx context.Context, c ClientCredentials, a Adjustment) (PaymentResponse, error) {
+	if a.Currency == "" {
+		a.Currency = p.Currency
+	}
+	if a.Currency == "" {
+		a.Currency = RealBrazilian
+	}
+	if p.PaymentID == "" {
+		return PaymentResponse{}, errPaymentID
+	}
+	endpoint := fmt.Sprintf(endpointPaymentCreditAdjustment, url.PathEscape(p.PaymentID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, a)
+	if err != nil {
+		return PaymentResponse{}, err
+	}
+
+	var pr PaymentResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
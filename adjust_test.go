@@ -0,0 +1,90 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentAdjust(t *testing.T) {
+	server := serverTestPaymentAdjust()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID, Status: PaymentAuthorized}
+	adjusted, err := pr.Adjust(credentials, 45.67)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !adjusted.Authorized() {
+		t.Errorf("Expected authorized payment, got '%s'", adjusted.Status)
+	}
+	if adjusted.Amount != 45.67 {
+		t.Errorf("Expected '%f', got '%f'", 45.67, adjusted.Amount)
+	}
+	if adjusted.Currency != RealBrazilian {
+		t.Errorf("Expected '%s', got '%s'", RealBrazilian, adjusted.Currency)
+	}
+
+	expected := "20000024"
+	if adjusted.Credit.AdjustmentAcquirerTransactionID != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, adjusted.Credit.AdjustmentAcquirerTransactionID)
+	}
+}
+
+func serverTestPaymentAdjust() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if req.URL.Path != "/v1/payments/credit/"+paymentID+"/adjustment" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		var payload struct {
+			Amount   int      `json:"amount"`
+			Currency Currency `json:"currency"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil || payload.Currency == "" {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprintf(rw, `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"amount": %d,
+"currency": "%s",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"status": "AUTHORIZED",
+"credit": {
+  "authorization_code": "000000099999",
+  "authorized_at": "2017-03-19T16:30:30Z",
+  "reason_code": "0",
+  "reason_message": "transaction approved",
+  "acquirer": "GETNET",
+  "soft_descriptor": "Descrição para fatura",
+  "terminal_nsu": "0099999",
+  "acquirer_transaction_id": "10000024",
+  "adjustment_acquirer_transaction_id": "20000024",
+  "transaction_id": "1002217281190421"
+}
+}`, payload.Amount, payload.Currency)
+	}))
+}
+
+func TestPaymentAdjustPaymentID(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	_, err := PaymentResponse{}.Adjust(credentials, 1.5)
+	if err != errPaymentID {
+		t.Errorf("Expected '%s', got '%v'", errPaymentID, err)
+	}
+}
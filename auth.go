@@ -1,9 +1,11 @@
 package getnet
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -24,6 +26,12 @@ type ClientCredentials struct {
 	SellerID     string
 	Sandbox      bool
 	AccessToken  AccessToken
+	// HTTPClient usado nas chamadas à API. Quando nulo, é utilizado um
+	// cliente compartilhado, com timeout e pool de conexões (NewHTTPClient).
+	HTTPClient *http.Client
+	// TokenSource usado quando AccessToken não é informado. Quando nulo, é
+	// utilizado um TokenSource compartilhado entre credenciais iguais.
+	TokenSource *TokenSource
 }
 
 func (cc ClientCredentials) Basic() string {
@@ -41,10 +49,14 @@ func (cc ClientCredentials) HasSeller() bool {
 }
 
 func (cc ClientCredentials) NewAccessToken() (AccessToken, error) {
+	return cc.NewAccessTokenContext(context.Background())
+}
+
+func (cc ClientCredentials) NewAccessTokenContext(ctx context.Context) (AccessToken, error) {
 	formData := url.Values{}
 	formData.Add("scope", "oob")
 	formData.Add("grant_type", "client_credentials")
-	res, err := NewRestClient(cc).AuthBasic().FormData(authTokenURL, formData)
+	res, err := NewRestClient(cc).WithContext(ctx).AuthBasic().FormData(authTokenURL, formData)
 	if err != nil {
 		return AccessToken{}, err
 	}
@@ -0,0 +1,163 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+var errBoletoID = errors.New("Obrigatório informar o identificador do boleto (boleto_id).")
+
+type BoletoProvider string
+
+const (
+	endpointPaymentBoleto     = "/v1/payments/boleto"
+	endpointPaymentBoletoPDF  = "/v1/payments/boleto/%s/pdf"
+	endpointPaymentBoletoHTML = "/v1/payments/boleto/%s/html"
+
+	boletoDateLayout = "02/01/2006"
+
+	linkBoletoPDF  = "boleto_pdf"
+	linkBoletoHTML = "boleto_html"
+
+	// Nome do provedor do boleto (provider)
+	Santander BoletoProvider = "santander"
+)
+
+type Boleto struct {
+	OurNumber      string         `json:"our_number,omitempty"`
+	DocumentNumber string         `json:"document_number"`
+	ExpirationDate time.Time      `json:"expiration_date"`
+	Instructions   string         `json:"instructions,omitempty"`
+	Provider       BoletoProvider `json:"provider"`
+}
+
+func (b Boleto) MarshalJSON() ([]byte, error) {
+	type Alias Boleto
+	var expirationDate string
+	if !b.ExpirationDate.IsZero() {
+		expirationDate = b.ExpirationDate.Format(boletoDateLayout)
+	}
+	return json.Marshal(&struct {
+		Alias
+		DocumentNumber string `json:"document_number"`
+		ExpirationDate string `json:"expiration_date,omitempty"`
+		Instructions   string `json:"instructions,omitempty"`
+	}{
+		Alias:          (Alias)(b),
+		DocumentNumber: maxLength(b.DocumentNumber, 15),
+		ExpirationDate: expirationDate,
+		Instructions:   maxLength(b.Instructions, 1000),
+	})
+}
+
+func (p Payment) PayBoleto(c ClientCredentials) (PaymentResponse, error) {
+	return p.PayBoletoContext(context.Background(), c)
+}
+
+func (p Payment) PayBoletoContext(ctx context.Context, c ClientCredentials) (PaymentResponse, error) {
+	if p.Currency == "" {
+		p.Currency = RealBrazilian
+	}
+	if p.Boleto.Provider == "" {
+		p.Boleto.Provider = Santander
+	}
+	p.Credit = Credit{}
+	p.Debit = Debit{}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointPaymentBoleto, p)
+	if err != nil {
+		return PaymentResponse{}, err
+	}
+
+	var pr PaymentResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
+
+type BoletoResponse struct {
+	BoletoID       string    `json:"boleto_id"`
+	Bank           int       `json:"bank"`
+	StatusCode     int       `json:"status_code"`
+	StatusLabel    string    `json:"status_label"`
+	TypefulLine    string    `json:"typeful_line"`
+	BarCode        string    `json:"bar_code"`
+	IssueDate      time.Time `json:"issue_date"`
+	ExpirationDate time.Time `json:"expiration_date"`
+	OurNumber      string    `json:"our_number"`
+	DocumentNumber string    `json:"document_number"`
+	Links          []Link    `json:"_links"`
+}
+
+func (br *BoletoResponse) UnmarshalJSON(data []byte) error {
+	type Alias BoletoResponse
+	aux := &struct {
+		*Alias
+		IssueDate      string `json:"issue_date"`
+		ExpirationDate string `json:"expiration_date"`
+	}{
+		Alias: (*Alias)(br),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	br.IssueDate, _ = time.Parse(boletoDateLayout, aux.IssueDate)
+	br.ExpirationDate, _ = time.Parse(boletoDateLayout, aux.ExpirationDate)
+	return nil
+}
+
+func (br BoletoResponse) PDF(c ClientCredentials) ([]byte, error) {
+	return br.PDFContext(context.Background(), c)
+}
+
+func (br BoletoResponse) PDFContext(ctx context.Context, c ClientCredentials) ([]byte, error) {
+	return br.download(ctx, c, linkBoletoPDF, endpointPaymentBoletoPDF)
+}
+
+func (br BoletoResponse) HTML(c ClientCredentials) ([]byte, error) {
+	return br.HTMLContext(context.Background(), c)
+}
+
+func (br BoletoResponse) HTMLContext(ctx context.Context, c ClientCredentials) ([]byte, error) {
+	return br.download(ctx, c, linkBoletoHTML, endpointPaymentBoletoHTML)
+}
+
+func (br BoletoResponse) download(ctx context.Context, c ClientCredentials, rel, endpoint string) ([]byte, error) {
+	if l, ok := br.link(rel); ok {
+		endpoint = l.endpoint()
+	} else if br.BoletoID == "" {
+		return nil, errBoletoID
+	} else {
+		endpoint = fmt.Sprintf(endpoint, url.PathEscape(br.BoletoID))
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (br BoletoResponse) link(rel string) (Link, bool) {
+	for _, l := range br.Links {
+		if l.Rel == rel {
+			return l, true
+		}
+	}
+	return Link{}, false
+}
+
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+	Type string `json:"type"`
+}
+
+func (l Link) endpoint() string {
+	u, err := url.Parse(l.Href)
+	if err != nil {
+		return l.Href
+	}
+	return u.RequestURI()
+}
@@ -0,0 +1,132 @@
+package getnet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	boletoID    = "0e2e5f5d-0d3c-4b1c-8a3e-6a1c9d1e0a4f"
+	typefulLine = "03399.32766 55400.000000 00000.101027 7 72510000000123"
+)
+
+func TestPaymentBoleto(t *testing.T) {
+	server := serverTestPaymentBoleto()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := Payment{
+		Amount: 1.23,
+		Boleto: Boleto{
+			DocumentNumber: "170500000019763",
+			ExpirationDate: time.Date(2017, 3, 30, 0, 0, 0, 0, time.UTC),
+			Instructions:   "Não receber após o vencimento",
+		},
+	}
+	pr, err := p.PayBoleto(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.Pending() {
+		t.Errorf("Expected pending payment, got '%s'", pr.Status)
+	}
+	if pr.Boleto.BoletoID != boletoID {
+		t.Errorf("Expected '%s', got '%s'", boletoID, pr.Boleto.BoletoID)
+	}
+	if pr.Boleto.TypefulLine != typefulLine {
+		t.Errorf("Expected '%s', got '%s'", typefulLine, pr.Boleto.TypefulLine)
+	}
+
+	expected := "2017-03-30 00:00:00 +0000 UTC"
+	if pr.Boleto.ExpirationDate.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, pr.Boleto.ExpirationDate.String())
+	}
+
+	pdf, err := pr.Boleto.PDF(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if string(pdf) != "%PDF-1.4" {
+		t.Errorf("Expected '%s', got '%s'", "%PDF-1.4", pdf)
+	}
+
+	html, err := pr.Boleto.HTML(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if string(html) != "<html></html>" {
+		t.Errorf("Expected '%s', got '%s'", "<html></html>", html)
+	}
+}
+
+func serverTestPaymentBoleto() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch req.URL.Path {
+		case "/v1/payments/boleto":
+			body, _ := ioutil.ReadAll(req.Body)
+			var payload struct {
+				Boleto struct {
+					ExpirationDate string         `json:"expiration_date"`
+					Provider       BoletoProvider `json:"provider"`
+				} `json:"boleto"`
+			}
+			json.Unmarshal(body, &payload)
+			if payload.Boleto.ExpirationDate != "30/03/2017" || payload.Boleto.Provider != Santander {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			rw.WriteHeader(http.StatusCreated)
+			payloadResponse := `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"amount": 123,
+"currency": "BRL",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"status": "PENDING",
+"boleto": {
+  "boleto_id": "0e2e5f5d-0d3c-4b1c-8a3e-6a1c9d1e0a4f",
+  "bank": 33,
+  "status_code": 1,
+  "status_label": "Pendente",
+  "typeful_line": "03399.32766 55400.000000 00000.101027 7 72510000000123",
+  "bar_code": "03397725100000001239327655400000000000010102",
+  "issue_date": "20/03/2017",
+  "expiration_date": "30/03/2017",
+  "our_number": "000001946598",
+  "document_number": "170500000019763",
+  "_links": [
+    {"href": "/v1/payments/boleto/0e2e5f5d-0d3c-4b1c-8a3e-6a1c9d1e0a4f/pdf", "rel": "boleto_pdf", "type": "GET"},
+    {"href": "/v1/payments/boleto/0e2e5f5d-0d3c-4b1c-8a3e-6a1c9d1e0a4f/html", "rel": "boleto_html", "type": "GET"}
+  ]
+}
+}`
+			rw.Write([]byte(payloadResponse))
+		case "/v1/payments/boleto/" + boletoID + "/pdf":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte("%PDF-1.4"))
+		case "/v1/payments/boleto/" + boletoID + "/html":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte("<html></html>"))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestBoletoDownloadBoletoID(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	_, err := BoletoResponse{}.PDF(credentials)
+	if err != errBoletoID {
+		t.Errorf("Expected '%s', got '%v'", errBoletoID, err)
+	}
+}
@@ -0,0 +1,214 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+var errPaymentID = errors.New("Obrigatório informar o identificador do pagamento (payment_id).")
+
+type CancelRequestStatus string
+
+const (
+	endpointPaymentCreditCancel = "/v1/payments/credit/%s/cancel"
+	endpointCancelRequest       = "/v1/payments/cancel/request"
+
+	// Status da solicitação de cancelamento (status)
+	CancelRequestAccepted CancelRequestStatus = "ACCEPTED"
+	CancelRequestPending  CancelRequestStatus = "PENDING"
+	CancelRequestDenied   CancelRequestStatus = "DENIED"
+	CancelRequestCanceled CancelRequestStatus = "CANCELED"
+)
+
+func (p PaymentResponse) Cancel(c ClientCredentials) (CancelResponse, error) {
+	return p.CancelContext(context.Background(), c)
+}
+
+func (p PaymentResponse) CancelContext(ctx context.Context, c ClientCredentials) (CancelResponse, error) {
+	if p.PaymentID == "" {
+		return CancelResponse{}, errPaymentID
+	}
+	endpoint := fmt.Sprintf(endpointPaymentCreditCancel, url.PathEscape(p.PaymentID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, struct{}{})
+	if err != nil {
+		return CancelResponse{}, err
+	}
+
+	var cr CancelResponse
+	err = json.Unmarshal(res.Body, &cr)
+	return cr, err
+}
+
+type CancelResponse struct {
+	PaymentID    string       `json:"payment_id"`
+	SellerID     string       `json:"seller_id"`
+	Amount       float64      `json:"amount"`
+	Currency     Currency     `json:"currency"`
+	OrderID      string       `json:"order_id"`
+	Status       string       `json:"status"`
+	CreditCancel CreditCancel `json:"credit_cancel"`
+}
+
+func (cr CancelResponse) Canceled() bool {
+	return cr.Status == PaymentCanceled
+}
+
+func (cr *CancelResponse) UnmarshalJSON(data []byte) error {
+	type Alias CancelResponse
+	aux := &struct {
+		*Alias
+		Amount int `json:"amount"`
+	}{
+		Alias: (*Alias)(cr),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	cr.Amount = fromCents(aux.Amount)
+	return nil
+}
+
+type CreditCancel struct {
+	CanceledAt time.Time `json:"canceled_at"`
+	Message    string    `json:"message"`
+}
+
+func (cc *CreditCancel) UnmarshalJSON(data []byte) error {
+	type Alias CreditCancel
+	aux := &struct {
+		*Alias
+		CanceledAt string `json:"canceled_at"`
+	}{
+		Alias: (*Alias)(cc),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	cc.CanceledAt, _ = time.Parse("2006-01-02T15:04:05Z", aux.CanceledAt)
+	return nil
+}
+
+type CancelRequest struct {
+	PaymentID       string  `json:"payment_id"`
+	CancelAmount    float64 `json:"cancel_amount"`
+	CancelCustomKey string  `json:"cancel_custom_key,omitempty"`
+}
+
+func (cr CancelRequest) MarshalJSON() ([]byte, error) {
+	type Alias CancelRequest
+	return json.Marshal(&struct {
+		Alias
+		CancelAmount int `json:"cancel_amount"`
+	}{
+		Alias:        (Alias)(cr),
+		CancelAmount: toCents(cr.CancelAmount),
+	})
+}
+
+func (cr CancelRequest) Request(c ClientCredentials) (CancelRequestResponse, error) {
+	return cr.RequestContext(context.Background(), c)
+}
+
+func (cr CancelRequest) RequestContext(ctx context.Context, c ClientCredentials) (CancelRequestResponse, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointCancelRequest, cr)
+	if err != nil {
+		return CancelRequestResponse{}, err
+	}
+
+	var crr CancelRequestResponse
+	err = json.Unmarshal(res.Body, &crr)
+	return crr, err
+}
+
+func (p PaymentResponse) RequestCancel(c ClientCredentials, customKey string) (CancelRequestResponse, error) {
+	return p.RequestCancelContext(context.Background(), c, customKey)
+}
+
+func (p PaymentResponse) RequestCancelContext(ctx context.Context, c ClientCredentials, customKey string) (CancelRequestResponse, error) {
+	return p.RequestPartialCancelContext(ctx, c, p.Amount, customKey)
+}
+
+func (p PaymentResponse) RequestPartialCancel(c ClientCredentials, amount float64, customKey string) (CancelRequestResponse, error) {
+	return p.RequestPartialCancelContext(context.Background(), c, amount, customKey)
+}
+
+func (p PaymentResponse) RequestPartialCancelContext(ctx context.Context, c ClientCredentials, amount float64, customKey string) (CancelRequestResponse, error) {
+	return CancelRequest{
+		PaymentID:       p.PaymentID,
+		CancelAmount:    amount,
+		CancelCustomKey: customKey,
+	}.RequestContext(ctx, c)
+}
+
+func GetCancelRequest(c ClientCredentials, cancelRequestID string) (CancelRequestResponse, error) {
+	return GetCancelRequestContext(context.Background(), c, cancelRequestID)
+}
+
+func GetCancelRequestContext(ctx context.Context, c ClientCredentials, cancelRequestID string) (CancelRequestResponse, error) {
+	return getCancelRequest(ctx, c, endpointCancelRequest+"/"+url.PathEscape(cancelRequestID))
+}
+
+func GetCancelRequestByCustomKey(c ClientCredentials, customKey string) (CancelRequestResponse, error) {
+	return GetCancelRequestByCustomKeyContext(context.Background(), c, customKey)
+}
+
+func GetCancelRequestByCustomKeyContext(ctx context.Context, c ClientCredentials, customKey string) (CancelRequestResponse, error) {
+	query := url.Values{}
+	query.Add("cancel_custom_key", customKey)
+	return getCancelRequest(ctx, c, endpointCancelRequest+"?"+query.Encode())
+}
+
+func getCancelRequest(ctx context.Context, c ClientCredentials, endpoint string) (CancelRequestResponse, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpoint)
+	if err != nil {
+		return CancelRequestResponse{}, err
+	}
+
+	var crr CancelRequestResponse
+	err = json.Unmarshal(res.Body, &crr)
+	return crr, err
+}
+
+type CancelRequestResponse struct {
+	SellerID        string              `json:"seller_id"`
+	PaymentID       string              `json:"payment_id"`
+	CancelRequestAt time.Time           `json:"cancel_request_at"`
+	CancelRequestID string              `json:"cancel_request_id"`
+	CancelCustomKey string              `json:"cancel_custom_key"`
+	Status          CancelRequestStatus `json:"status"`
+}
+
+func (crr CancelRequestResponse) Accepted() bool {
+	return crr.Status == CancelRequestAccepted
+}
+
+func (crr CancelRequestResponse) Pending() bool {
+	return crr.Status == CancelRequestPending
+}
+
+func (crr CancelRequestResponse) Denied() bool {
+	return crr.Status == CancelRequestDenied
+}
+
+func (crr CancelRequestResponse) Canceled() bool {
+	return crr.Status == CancelRequestCanceled
+}
+
+func (crr *CancelRequestResponse) UnmarshalJSON(data []byte) error {
+	type Alias CancelRequestResponse
+	aux := &struct {
+		*Alias
+		CancelRequestAt string `json:"cancel_request_at"`
+	}{
+		Alias: (*Alias)(crr),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	crr.CancelRequestAt, _ = time.Parse("2006-01-02T15:04:05.000Z", aux.CancelRequestAt)
+	return nil
+}
@@ -0,0 +1,226 @@
+package getnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	paymentID = "06f256c8-1bbf-42bf-93b4-ce2041bfb87e"
+)
+
+func TestPaymentCancel(t *testing.T) {
+	server := serverTestPaymentCancel()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID}
+	cr, err := pr.Cancel(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !cr.Canceled() {
+		t.Errorf("Expected canceled payment, got '%s'", cr.Status)
+	}
+	if cr.Amount != 1.23 {
+		t.Errorf("Expected '%f', got '%f'", 1.23, cr.Amount)
+	}
+
+	expected := "2017-03-19 16:30:30 +0000 UTC"
+	if cr.CreditCancel.CanceledAt.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, cr.CreditCancel.CanceledAt.String())
+	}
+
+	expected = "Credit transaction cancelled successfully"
+	if cr.CreditCancel.Message != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, cr.CreditCancel.Message)
+	}
+}
+
+func TestPaymentCancelPaymentID(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		path = req.URL.EscapedPath()
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`{"status": "CANCELED"}`))
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	_, err := PaymentResponse{}.Cancel(credentials)
+	if err != errPaymentID {
+		t.Errorf("Expected '%s', got '%v'", errPaymentID, err)
+	}
+	if path != "" {
+		t.Errorf("Expected no request, got '%s'", path)
+	}
+
+	_, err = PaymentResponse{PaymentID: "a/b?c"}.Cancel(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	expected := "/v1/payments/credit/a%2Fb%3Fc/cancel"
+	if path != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, path)
+	}
+}
+
+func TestPaymentCancelNotFound(t *testing.T) {
+	errorMessage := "Transação não encontrada."
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		err := ErrorResponseSchemaV1{Details: []Detail{
+			{DescriptionDetail: errorMessage},
+		},
+			Message: errorMessage}
+		rw.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(rw).Encode(err)
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID}
+	_, err := pr.Cancel(credentials)
+	if err == nil || err.Error() != errorMessage {
+		t.Errorf("Expected '%s', got '%v'", errorMessage, err)
+	}
+}
+
+func serverTestPaymentCancel() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if req.URL.Path != "/v1/payments/credit/"+paymentID+"/cancel" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		auth := req.Header.Get("Authorization")
+		if !strings.Contains(auth, "Bearer") {
+			err := ErrorResponseSchemaV1{
+				Message: "Invalid Authorization",
+				Name:    "auth/bearer"}
+			rw.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(rw).Encode(err)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		payload := `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"amount": 123,
+"currency": "BRL",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"status": "CANCELED",
+"credit_cancel": {
+  "canceled_at": "2017-03-19T16:30:30Z",
+  "message": "Credit transaction cancelled successfully"
+}
+}`
+		rw.Write([]byte(payload))
+	}))
+}
+
+func TestPaymentRequestCancel(t *testing.T) {
+	server := serverTestCancelRequest()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID, Amount: 1.23}
+	crr, err := pr.RequestCancel(credentials, cancelCustomKey)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !crr.Accepted() {
+		t.Errorf("Expected accepted cancel request, got '%s'", crr.Status)
+	}
+	if crr.CancelRequestID != cancelRequestID {
+		t.Errorf("Expected '%s', got '%s'", cancelRequestID, crr.CancelRequestID)
+	}
+
+	expected := "2017-03-19 16:30:30.764 +0000 UTC"
+	if crr.CancelRequestAt.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, crr.CancelRequestAt.String())
+	}
+}
+
+func TestGetCancelRequest(t *testing.T) {
+	server := serverTestCancelRequest()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	crr, err := GetCancelRequest(credentials, cancelRequestID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !crr.Canceled() {
+		t.Errorf("Expected canceled request, got '%s'", crr.Status)
+	}
+
+	crr, err = GetCancelRequestByCustomKey(credentials, cancelCustomKey)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !crr.Canceled() {
+		t.Errorf("Expected canceled request, got '%s'", crr.Status)
+	}
+	if crr.CancelCustomKey != cancelCustomKey {
+		t.Errorf("Expected '%s', got '%s'", cancelCustomKey, crr.CancelCustomKey)
+	}
+}
+
+const (
+	cancelRequestID = "20170319163030764001"
+	cancelCustomKey = "01e2d3c4-b5a6-4f78-9012-3456789abcde"
+)
+
+func serverTestCancelRequest() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		status := CancelRequestCanceled
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/payments/cancel/request":
+			var cr struct {
+				PaymentID    string `json:"payment_id"`
+				CancelAmount int    `json:"cancel_amount"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&cr); err != nil || cr.CancelAmount != 123 {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			status = CancelRequestAccepted
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/payments/cancel/request/"+cancelRequestID:
+		case req.Method == http.MethodGet && req.URL.Query().Get("cancel_custom_key") == cancelCustomKey:
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"seller_id":         "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+			"payment_id":        paymentID,
+			"cancel_request_at": "2017-03-19T16:30:30.764Z",
+			"cancel_request_id": cancelRequestID,
+			"cancel_custom_key": cancelCustomKey,
+			"status":            status,
+		})
+	}))
+}
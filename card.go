@@ -1,6 +1,7 @@
 package getnet
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 )
@@ -37,6 +38,10 @@ type Card struct {
 }
 
 func (c Card) Token(cc ClientCredentials) (string, error) {
+	return c.TokenContext(context.Background(), cc)
+}
+
+func (c Card) TokenContext(ctx context.Context, cc ClientCredentials) (string, error) {
 	payload := struct {
 		CardNumber string `json:"card_number"`
 		CustomerID string `json:"customer_id,omitempty"`
@@ -45,7 +50,7 @@ func (c Card) Token(cc ClientCredentials) (string, error) {
 		CustomerID: c.CustomerID,
 	}
 
-	res, err := NewRestClient(cc).Post(endpointTokenCard, payload)
+	res, err := NewRestClient(cc).WithContext(ctx).Post(endpointTokenCard, payload)
 	if err != nil {
 		return "", err
 	}
@@ -56,6 +61,10 @@ func (c Card) Token(cc ClientCredentials) (string, error) {
 }
 
 func (c Card) Verify(cc ClientCredentials) (Verification, error) {
+	return c.VerifyContext(context.Background(), cc)
+}
+
+func (c Card) VerifyContext(ctx context.Context, cc ClientCredentials) (Verification, error) {
 	if c.NumberToken == "" {
 		return Verification{}, errNumberToken
 	}
@@ -64,7 +73,7 @@ func (c Card) Verify(cc ClientCredentials) (Verification, error) {
 		return Verification{Status: NotVerified}, nil
 	}
 
-	res, err := NewRestClient(cc).Post(endpointCardVerification, c)
+	res, err := NewRestClient(cc).WithContext(ctx).Post(endpointCardVerification, c)
 	if err != nil {
 		return Verification{}, err
 	}
@@ -0,0 +1,152 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type ChargeStatus string
+
+const (
+	endpointCharges     = "/v1/charges"
+	endpointChargeRetry = "/v1/charges/%s/retry"
+
+	chargeDateLayout = "2006-01-02"
+
+	// Status da cobrança (status)
+	ChargeScheduled ChargeStatus = "scheduled"
+	ChargePaid      ChargeStatus = "paid"
+	ChargeDenied    ChargeStatus = "denied"
+	ChargeCanceled  ChargeStatus = "canceled"
+	ChargeError     ChargeStatus = "error"
+)
+
+type Charge struct {
+	ChargeID              string          `json:"charge_id"`
+	SellerID              string          `json:"seller_id"`
+	SubscriptionID        string          `json:"subscription_id"`
+	CustomerID            string          `json:"customer_id"`
+	PlanID                string          `json:"plan_id"`
+	PaymentID             string          `json:"payment_id"`
+	Amount                float64         `json:"amount"`
+	Status                ChargeStatus    `json:"status"`
+	ScheduledDate         time.Time       `json:"scheduled_date"`
+	CreateDate            time.Time       `json:"create_date"`
+	PaymentDate           time.Time       `json:"payment_date"`
+	RetryNumber           int             `json:"retry_number"`
+	PaymentType           string          `json:"payment_type"`
+	TerminalNSU           string          `json:"terminal_nsu"`
+	AuthorizationCode     string          `json:"authorization_code"`
+	AcquirerTransactionID string          `json:"acquirer_transaction_id"`
+	Payment               PaymentResponse `json:"payment"`
+}
+
+func (ch Charge) Paid() bool {
+	return ch.Status == ChargePaid
+}
+
+func (ch Charge) Failed() bool {
+	return ch.Status == ChargeDenied || ch.Status == ChargeError
+}
+
+func (ch *Charge) UnmarshalJSON(data []byte) error {
+	type Alias Charge
+	aux := &struct {
+		*Alias
+		Amount        int    `json:"amount"`
+		ScheduledDate string `json:"scheduled_date"`
+		CreateDate    string `json:"create_date"`
+		PaymentDate   string `json:"payment_date"`
+	}{
+		Alias: (*Alias)(ch),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	ch.Amount = fromCents(aux.Amount)
+	ch.ScheduledDate = parseDate(aux.ScheduledDate)
+	ch.CreateDate = parseDate(aux.CreateDate)
+	ch.PaymentDate = parseDate(aux.PaymentDate)
+	return nil
+}
+
+func (ch Charge) Retry(c ClientCredentials) (Charge, error) {
+	return ch.RetryContext(context.Background(), c)
+}
+
+func (ch Charge) RetryContext(ctx context.Context, c ClientCredentials) (Charge, error) {
+	endpoint := fmt.Sprintf(endpointChargeRetry, url.PathEscape(ch.ChargeID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, struct{}{})
+	if err != nil {
+		return Charge{}, err
+	}
+
+	var retried Charge
+	err = json.Unmarshal(res.Body, &retried)
+	return retried, err
+}
+
+func GetCharge(c ClientCredentials, chargeID string) (Charge, error) {
+	return GetChargeContext(context.Background(), c, chargeID)
+}
+
+func GetChargeContext(ctx context.Context, c ClientCredentials, chargeID string) (Charge, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointCharges + "/" + url.PathEscape(chargeID))
+	if err != nil {
+		return Charge{}, err
+	}
+
+	var ch Charge
+	err = json.Unmarshal(res.Body, &ch)
+	return ch, err
+}
+
+func ListCharges(c ClientCredentials, f ChargeFilter) (ChargeList, error) {
+	return ListChargesContext(context.Background(), c, f)
+}
+
+func ListChargesContext(ctx context.Context, c ClientCredentials, f ChargeFilter) (ChargeList, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointCharges + "?" + f.query().Encode())
+	if err != nil {
+		return ChargeList{}, err
+	}
+
+	var cl ChargeList
+	err = json.Unmarshal(res.Body, &cl)
+	return cl, err
+}
+
+type ChargeFilter struct {
+	Page           int
+	Limit          int
+	SubscriptionID string
+	CustomerID     string
+	Status         ChargeStatus
+	ChargeDateInit time.Time
+	ChargeDateEnd  time.Time
+	SortType       string
+}
+
+func (f ChargeFilter) query() url.Values {
+	query := url.Values{}
+	addPaging(query, f.Page, f.Limit)
+	addQuery(query, "subscription_id", f.SubscriptionID)
+	addQuery(query, "customer_id", f.CustomerID)
+	addQuery(query, "status", string(f.Status))
+	if !f.ChargeDateInit.IsZero() {
+		query.Add("charge_date_init", f.ChargeDateInit.Format(chargeDateLayout))
+	}
+	if !f.ChargeDateEnd.IsZero() {
+		query.Add("charge_date_end", f.ChargeDateEnd.Format(chargeDateLayout))
+	}
+	addQuery(query, "sort_type", f.SortType)
+	return query
+}
+
+type ChargeList struct {
+	Paging
+	Charges []Charge `json:"charges"`
+}
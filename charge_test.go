@@ -0,0 +1,123 @@
+package getnet
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	chargeID = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+)
+
+func TestListCharges(t *testing.T) {
+	server := serverTestCharges()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	f := ChargeFilter{
+		SubscriptionID: subscriptionID,
+		Status:         ChargeDenied,
+		ChargeDateInit: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		ChargeDateEnd:  time.Date(2018, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	cl, err := ListCharges(credentials, f)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(cl.Charges) != 1 {
+		t.Fatalf("Expected 1 charge, got %d", len(cl.Charges))
+	}
+	if !cl.Charges[0].Failed() {
+		t.Errorf("Expected failed charge, got '%s'", cl.Charges[0].Status)
+	}
+	if cl.Charges[0].Amount != 99.9 {
+		t.Errorf("Expected '%f', got '%f'", 99.9, cl.Charges[0].Amount)
+	}
+}
+
+func TestGetAndRetryCharge(t *testing.T) {
+	server := serverTestCharges()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	ch, err := GetCharge(credentials, chargeID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if ch.Payment.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, ch.Payment.PaymentID)
+	}
+	if !ch.Payment.Denied() {
+		t.Errorf("Expected denied payment, got '%s'", ch.Payment.Status)
+	}
+
+	expected := "2018-01-27 00:00:00 +0000 UTC"
+	if ch.ScheduledDate.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, ch.ScheduledDate.String())
+	}
+
+	ch, err = ch.Retry(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !ch.Paid() {
+		t.Errorf("Expected paid charge, got '%s'", ch.Status)
+	}
+	if ch.RetryNumber != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, ch.RetryNumber)
+	}
+}
+
+func serverTestCharges() *httptest.Server {
+	charge := func(status ChargeStatus, paymentStatus string, retry int) string {
+		return fmt.Sprintf(`{
+"charge_id": "%s",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"subscription_id": "%s",
+"customer_id": "%s",
+"plan_id": "%s",
+"payment_id": "%s",
+"amount": 9990,
+"status": "%s",
+"scheduled_date": "2018-01-27",
+"create_date": "2017-12-27T16:28:45.116Z",
+"retry_number": %d,
+"payment_type": "credit_card",
+"payment": {"payment_id": "%s", "amount": 9990, "status": "%s"}
+}`, chargeID, subscriptionID, customerID, planID, paymentID, status, retry, paymentID, paymentStatus)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/charges":
+			query := req.URL.Query()
+			if query.Get("subscription_id") != subscriptionID ||
+				query.Get("charge_date_init") != "2018-01-01" ||
+				query.Get("charge_date_end") != "2018-01-31" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, `{"page": 1, "limit": 10, "total": 1, "charges": [%s]}`,
+				charge(ChargeStatus(query.Get("status")), PaymentDenied, 0))
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/charges/"+chargeID:
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(charge(ChargeDenied, PaymentDenied, 0)))
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/charges/"+chargeID+"/retry":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(charge(ChargePaid, PaymentApproved, 1)))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
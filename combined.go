@@ -0,0 +1,99 @@
+package getnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	errCombinedAmount = errors.New("A soma dos valores dos cartões deve ser igual ao valor do pagamento (amount).")
+	errCombinedSplit  = errors.New("Split de pagamento (marketplace) não é suportado no pagamento combinado com dois cartões.")
+)
+
+type CombinedPayment struct {
+	Payment Payment
+	Legs    [2]PaymentLeg
+}
+
+type PaymentLeg struct {
+	Amount float64
+	Credit Credit
+}
+
+// Pay cobra os dois cartões como um único pedido. Cada cartão é enviado como
+// um pagamento próprio, com o order_id do pedido, e recuperado pela consulta
+// por order_id em falhas ambíguas (ver SafePay), distinguindo os cartões pelo
+// valor, pela bandeira e pelo pagamento já atribuído ao primeiro. Se o segundo
+// cartão for recusado após a aprovação do primeiro, o primeiro pagamento é
+// cancelado; se o resultado do segundo não puder ser determinado, nada é
+// cancelado e é retornado um erro.
+func (cp CombinedPayment) Pay(c ClientCredentials) (CombinedResponse, error) {
+	return cp.PayContext(context.Background(), c)
+}
+
+func (cp CombinedPayment) PayContext(ctx context.Context, c ClientCredentials) (CombinedResponse, error) {
+	if toCents(cp.Legs[0].Amount)+toCents(cp.Legs[1].Amount) != toCents(cp.Payment.Amount) {
+		return CombinedResponse{}, errCombinedAmount
+	}
+	if len(cp.Payment.MarketplaceSubsellerPayments) > 0 {
+		return CombinedResponse{}, errCombinedSplit
+	}
+	if cp.Payment.Order.OrderID == "" {
+		return CombinedResponse{}, errOrderID
+	}
+
+	var cr CombinedResponse
+	first, resolved, err := cp.leg(0).safePay(ctx, c)
+	cr.First = first.PaymentResponse
+	if err != nil && !resolved {
+		return cr, fmt.Errorf("Não foi possível determinar o resultado do primeiro cartão (order_id %s): %w", cp.Payment.Order.OrderID, err)
+	}
+	if err != nil || !accepted(cr.First) {
+		return cr, err
+	}
+
+	second, resolved, err := cp.leg(1).safePay(ctx, c, cr.First.PaymentID)
+	cr.Second = second.PaymentResponse
+	if err == nil && accepted(cr.Second) {
+		return cr, nil
+	}
+	if !resolved || (err == nil && !cr.Second.Denied()) {
+		if err == nil {
+			err = fmt.Errorf("status %s", cr.Second.Status)
+		}
+		return cr, fmt.Errorf("Não foi possível determinar o resultado do segundo cartão (order_id %s); o pagamento %s do primeiro cartão não foi cancelado: %w", cp.Payment.Order.OrderID, cr.First.PaymentID, err)
+	}
+
+	cancel, cerr := cr.First.CancelContext(ctx, c)
+	if cerr != nil {
+		return cr, fmt.Errorf("Não foi possível cancelar o pagamento %s após a recusa do segundo cartão: %w", cr.First.PaymentID, cerr)
+	}
+	cr.Rollback = &cancel
+	return cr, err
+}
+
+func (cp CombinedPayment) leg(i int) Payment {
+	p := cp.Payment
+	p.Amount = cp.Legs[i].Amount
+	p.Credit = cp.Legs[i].Credit
+	return p
+}
+
+func accepted(pr PaymentResponse) bool {
+	return pr.Approved() || pr.Authorized() || pr.Confirmed()
+}
+
+type CombinedResponse struct {
+	First    PaymentResponse
+	Second   PaymentResponse
+	Rollback *CancelResponse
+}
+
+func (cr CombinedResponse) Approved() bool {
+	return cr.Rollback == nil && accepted(cr.First) && accepted(cr.Second)
+}
+
+func (cr CombinedResponse) RolledBack() bool {
+	return cr.Rollback != nil
+}
@@ -0,0 +1,272 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCombinedPaymentApproved(t *testing.T) {
+	server := serverTestCombinedPayment(PaymentApproved, PaymentApproved)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cr, err := fixtureCombinedPayment().Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !cr.Approved() {
+		t.Errorf("Expected approved combined payment, got '%s' and '%s'", cr.First.Status, cr.Second.Status)
+	}
+	if cr.First.Amount != 6 || cr.Second.Amount != 4 {
+		t.Errorf("Expected '6' and '4', got '%f' and '%f'", cr.First.Amount, cr.Second.Amount)
+	}
+}
+
+func TestCombinedPaymentRollback(t *testing.T) {
+	server := serverTestCombinedPayment(PaymentApproved, PaymentDenied)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cr, err := fixtureCombinedPayment().Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if cr.Approved() {
+		t.Errorf("Expected not approved combined payment")
+	}
+	if !cr.RolledBack() || !cr.Rollback.Canceled() {
+		t.Errorf("Expected first payment to be canceled")
+	}
+	if !cr.Second.Denied() {
+		t.Errorf("Expected denied second payment, got '%s'", cr.Second.Status)
+	}
+}
+
+func TestCombinedPaymentFirstDenied(t *testing.T) {
+	server := serverTestCombinedPayment(PaymentDenied, PaymentApproved)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cr, err := fixtureCombinedPayment().Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if cr.Second.PaymentID != "" {
+		t.Errorf("Expected second card not to be charged")
+	}
+	if cr.RolledBack() {
+		t.Errorf("Expected no rollback")
+	}
+}
+
+func TestCombinedPaymentAmount(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	cp := fixtureCombinedPayment()
+	cp.Payment.Amount = 11
+	_, err := cp.Pay(credentials)
+	if err != errCombinedAmount {
+		t.Errorf("Expected '%s', got '%v'", errCombinedAmount, err)
+	}
+}
+
+func TestCombinedPaymentSecondRecovered(t *testing.T) {
+	var cancels int32
+	server := serverTestCombinedAmbiguous(&cancels, true)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cr, err := fixtureCombinedPayment().Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !cr.Approved() {
+		t.Errorf("Expected approved combined payment, got '%s' and '%s'", cr.First.Status, cr.Second.Status)
+	}
+	if cr.Second.PaymentID != "second" {
+		t.Errorf("Expected '%s', got '%s'", "second", cr.Second.PaymentID)
+	}
+	if cancels != 0 {
+		t.Errorf("Expected first payment not to be canceled")
+	}
+}
+
+func TestCombinedPaymentSecondRecoveredSameAmount(t *testing.T) {
+	var cancels int32
+	server := serverTestCombinedAmbiguous(&cancels, true)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cp := fixtureCombinedPayment()
+	cp.Legs[0].Amount = 5
+	cp.Legs[1].Amount = 5
+	cr, err := cp.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if cr.Second.PaymentID != "second" {
+		t.Errorf("Expected '%s', got '%s'", "second", cr.Second.PaymentID)
+	}
+	if !cr.Approved() || cancels != 0 {
+		t.Errorf("Expected approved combined payment")
+	}
+}
+
+func TestCombinedPaymentSecondUnresolved(t *testing.T) {
+	var cancels int32
+	server := serverTestCombinedAmbiguous(&cancels, false)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cr, err := fixtureCombinedPayment().Pay(credentials)
+	if err == nil || !strings.Contains(err.Error(), "Não foi possível determinar o resultado do segundo cartão") {
+		t.Errorf("Expected unresolved second payment error, got '%v'", err)
+	}
+	if cr.RolledBack() || cancels != 0 {
+		t.Errorf("Expected first payment not to be canceled")
+	}
+	if cr.Approved() {
+		t.Errorf("Expected not approved combined payment")
+	}
+}
+
+func TestCombinedPaymentSplit(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	cp := fixtureCombinedPayment()
+	cp.Payment.MarketplaceSubsellerPayments = []SubsellerPayment{{SubsellerID: "1", SubsellerSalesAmount: 10}}
+	_, err := cp.Pay(credentials)
+	if err != errCombinedSplit {
+		t.Errorf("Expected '%s', got '%v'", errCombinedSplit, err)
+	}
+}
+
+func fixtureCombinedPayment() CombinedPayment {
+	return CombinedPayment{
+		Payment: Payment{
+			Amount: 10,
+			Order:  Order{OrderID: "6d2e4380-d8a3-4ccb-9138-c289182818a3"},
+		},
+		Legs: [2]PaymentLeg{
+			{Amount: 6, Credit: Credit{Card: Card{NumberToken: "first"}}},
+			{Amount: 4, Credit: Credit{Card: Card{NumberToken: "second"}}},
+		},
+	}
+}
+
+func serverTestCombinedPayment(first, second string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if strings.HasSuffix(req.URL.Path, "/cancel") {
+			if req.URL.Path != "/v1/payments/credit/first/cancel" {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"payment_id": "first", "status": "CANCELED"}`))
+			return
+		}
+
+		var p struct {
+			Amount int `json:"amount"`
+			Order  struct {
+				OrderID string `json:"order_id"`
+			} `json:"order"`
+			Credit struct {
+				Card struct {
+					NumberToken string `json:"number_token"`
+				} `json:"card"`
+			} `json:"credit"`
+		}
+		json.NewDecoder(req.Body).Decode(&p)
+
+		status := first
+		if p.Credit.Card.NumberToken == "second" {
+			status = second
+		}
+		if p.Order.OrderID != fixtureCombinedPayment().Payment.Order.OrderID {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rw.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(rw, `{"payment_id": "%s", "amount": %d, "status": "%s"}`,
+			p.Credit.Card.NumberToken, p.Amount, status)
+	}))
+}
+
+// serverTestCombinedAmbiguous falha o envio do segundo cartão com 502 após
+// processá-lo; found indica se a consulta por order_id encontra os pagamentos
+// dos dois cartões, ambos com o order_id do pedido.
+func serverTestCombinedAmbiguous(cancels *int32, found bool) *httptest.Server {
+	var mu sync.Mutex
+	var processed []string
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if strings.HasSuffix(req.URL.Path, "/cancel") {
+			atomic.AddInt32(cancels, 1)
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"payment_id": "first", "status": "CANCELED"}`))
+			return
+		}
+
+		if req.Method == http.MethodGet {
+			if !found || req.URL.Query().Get("order_id") != fixtureCombinedPayment().Payment.Order.OrderID {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, `{"payments": [%s]}`, strings.Join(processed, ","))
+			return
+		}
+
+		var p struct {
+			Amount int `json:"amount"`
+			Credit struct {
+				Card struct {
+					NumberToken string `json:"number_token"`
+				} `json:"card"`
+			} `json:"credit"`
+		}
+		json.NewDecoder(req.Body).Decode(&p)
+
+		payment := fmt.Sprintf(`{"payment_id": "%s", "amount": %d, "status": "APPROVED", "received_at": "%s"}`,
+			p.Credit.Card.NumberToken, p.Amount, time.Now().UTC().Format("2006-01-02T15:04:05.000Z"))
+		mu.Lock()
+		processed = append(processed, payment)
+		mu.Unlock()
+
+		if p.Credit.Card.NumberToken == "second" {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(payment))
+	}))
+}
@@ -0,0 +1,97 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	endpointPaymentCreditConfirm = "/v1/payments/credit/%s/confirm"
+)
+
+func (p PaymentResponse) Confirm(c ClientCredentials) (ConfirmResponse, error) {
+	return p.ConfirmContext(context.Background(), c)
+}
+
+func (p PaymentResponse) ConfirmContext(ctx context.Context, c ClientCredentials) (ConfirmResponse, error) {
+	return p.confirm(ctx, c, confirmation{})
+}
+
+func (p PaymentResponse) ConfirmAmount(c ClientCredentials, amount float64) (ConfirmResponse, error) {
+	return p.ConfirmAmountContext(context.Background(), c, amount)
+}
+
+func (p PaymentResponse) ConfirmAmountContext(ctx context.Context, c ClientCredentials, amount float64) (ConfirmResponse, error) {
+	return p.confirm(ctx, c, confirmation{Amount: toCents(amount)})
+}
+
+func (p PaymentResponse) confirm(ctx context.Context, c ClientCredentials, payload confirmation) (ConfirmResponse, error) {
+	if p.PaymentID == "" {
+		return ConfirmResponse{}, errPaymentID
+	}
+	endpoint := fmt.Sprintf(endpointPaymentCreditConfirm, url.PathEscape(p.PaymentID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, payload)
+	if err != nil {
+		return ConfirmResponse{}, err
+	}
+
+	var cr ConfirmResponse
+	err = json.Unmarshal(res.Body, &cr)
+	return cr, err
+}
+
+type confirmation struct {
+	Amount int `json:"amount,omitempty"`
+}
+
+type ConfirmResponse struct {
+	PaymentID     string        `json:"payment_id"`
+	SellerID      string        `json:"seller_id"`
+	Amount        float64       `json:"amount"`
+	Currency      Currency      `json:"currency"`
+	OrderID       string        `json:"order_id"`
+	Status        string        `json:"status"`
+	CreditConfirm CreditConfirm `json:"credit_confirm"`
+}
+
+func (cr ConfirmResponse) Confirmed() bool {
+	return cr.Status == PaymentConfirmed
+}
+
+func (cr *ConfirmResponse) UnmarshalJSON(data []byte) error {
+	type Alias ConfirmResponse
+	aux := &struct {
+		*Alias
+		Amount int `json:"amount"`
+	}{
+		Alias: (*Alias)(cr),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	cr.Amount = fromCents(aux.Amount)
+	return nil
+}
+
+type CreditConfirm struct {
+	ConfirmDate time.Time `json:"confirm_date"`
+	Message     string    `json:"message"`
+}
+
+func (cc *CreditConfirm) UnmarshalJSON(data []byte) error {
+	type Alias CreditConfirm
+	aux := &struct {
+		*Alias
+		ConfirmDate string `json:"confirm_date"`
+	}{
+		Alias: (*Alias)(cc),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	cc.ConfirmDate, _ = time.Parse("2006-01-02T15:04:05Z", aux.ConfirmDate)
+	return nil
+}
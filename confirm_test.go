@@ -0,0 +1,99 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentConfirm(t *testing.T) {
+	server := serverTestPaymentConfirm()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID, Status: PaymentAuthorized}
+	cr, err := pr.Confirm(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !cr.Confirmed() {
+		t.Errorf("Expected confirmed payment, got '%s'", cr.Status)
+	}
+	if cr.Amount != 1.23 {
+		t.Errorf("Expected '%f', got '%f'", 1.23, cr.Amount)
+	}
+
+	expected := "2017-03-19 16:30:30 +0000 UTC"
+	if cr.CreditConfirm.ConfirmDate.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, cr.CreditConfirm.ConfirmDate.String())
+	}
+}
+
+func TestPaymentConfirmAmount(t *testing.T) {
+	server := serverTestPaymentConfirm()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr := PaymentResponse{PaymentID: paymentID, Status: PaymentAuthorized}
+	cr, err := pr.ConfirmAmount(credentials, 0.5)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if cr.Amount != 0.5 {
+		t.Errorf("Expected '%f', got '%f'", 0.5, cr.Amount)
+	}
+}
+
+func serverTestPaymentConfirm() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if req.URL.Path != "/v1/payments/credit/"+paymentID+"/confirm" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		var payload struct {
+			Amount int `json:"amount"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if payload.Amount == 0 {
+			payload.Amount = 123
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprintf(rw, `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"amount": %d,
+"currency": "BRL",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"status": "CONFIRMED",
+"credit_confirm": {
+  "confirm_date": "2017-03-19T16:30:30Z",
+  "message": "Credit transaction confirmed successfully"
+}
+}`, payload.Amount)
+	}))
+}
+
+func TestPaymentConfirmPaymentID(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	_, err := PaymentResponse{}.Confirm(credentials)
+	if err != errPaymentID {
+		t.Errorf("Expected '%s', got '%v'", errPaymentID, err)
+	}
+}
@@ -0,0 +1,35 @@
+package getnet
+
+import (
+	"errors"
+)
+
+var errOriginalTransactionID = errors.New("Obrigatório informar o identificador da transação original (transaction_id) em cobranças iniciadas pelo lojista.")
+
+type CredentialsOnFileType string
+type Initiator string
+
+const (
+	// Tipo de uso da credencial armazenada (credentials_on_file.type)
+	OneClick    CredentialsOnFileType = "ONE_CLICK"
+	Recurring   CredentialsOnFileType = "RECURRING"
+	Unscheduled CredentialsOnFileType = "UNSCHEDULED"
+
+	// Quem iniciou a cobrança (credentials_on_file.initiator)
+	InitiatorCardholder Initiator = "CARDHOLDER"
+	InitiatorMerchant   Initiator = "MERCHANT"
+)
+
+type CredentialsOnFile struct {
+	Type      CredentialsOnFileType `json:"type"`
+	Initiator Initiator             `json:"initiator"`
+	// TransactionID (CreditResponse) da primeira transação com o cartão armazenado.
+	TransactionID string `json:"transaction_id,omitempty"`
+}
+
+func (cof CredentialsOnFile) validate() error {
+	if cof.Initiator == InitiatorMerchant && cof.TransactionID == "" {
+		return errOriginalTransactionID
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package getnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentCredentialsOnFile(t *testing.T) {
+	var got CredentialsOnFile
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var p struct {
+			Credit struct {
+				CredentialsOnFile CredentialsOnFile `json:"credentials_on_file"`
+			} `json:"credit"`
+		}
+		json.NewDecoder(req.Body).Decode(&p)
+		got = p.Credit.CredentialsOnFile
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(paymentCreditPayload))
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	vc := VaultCard{Card: Card{NumberToken: numberToken}}
+	p := Payment{
+		Amount: 1.23,
+		Credit: vc.Credit(Unscheduled, "1002217281190421"),
+	}
+	_, err := p.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if got.Type != Unscheduled || got.Initiator != InitiatorMerchant {
+		t.Errorf("Expected '%s' by '%s', got '%s' by '%s'", Unscheduled, InitiatorMerchant, got.Type, got.Initiator)
+	}
+	if got.TransactionID != "1002217281190421" {
+		t.Errorf("Expected '%s', got '%s'", "1002217281190421", got.TransactionID)
+	}
+}
+
+func TestVaultCardOneClick(t *testing.T) {
+	vc := VaultCard{Card: Card{NumberToken: numberToken}}
+	credit := vc.Credit(OneClick, "")
+	if credit.Card.NumberToken != numberToken {
+		t.Errorf("Expected '%s', got '%s'", numberToken, credit.Card.NumberToken)
+	}
+	if credit.CredentialsOnFile.Initiator != InitiatorCardholder {
+		t.Errorf("Expected '%s', got '%s'", InitiatorCardholder, credit.CredentialsOnFile.Initiator)
+	}
+}
+
+func TestPaymentCredentialsOnFileTransactionIDRequired(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	vc := VaultCard{Card: Card{NumberToken: numberToken}}
+	p := Payment{
+		Amount: 1.23,
+		Credit: vc.Credit(Recurring, ""),
+	}
+	_, err := p.Pay(credentials)
+	if err != errOriginalTransactionID {
+		t.Errorf("Expected '%s', got '%v'", errOriginalTransactionID, err)
+	}
+}
+
+func TestSubscriptionCredentialsOnFile(t *testing.T) {
+	s := Subscription{Card: Card{NumberToken: numberToken}, OriginalTransactionID: "1002217281190421"}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	var payload struct {
+		Subscription struct {
+			PaymentType struct {
+				Credit struct {
+					CredentialsOnFile CredentialsOnFile `json:"credentials_on_file"`
+				} `json:"credit"`
+			} `json:"payment_type"`
+		} `json:"subscription"`
+	}
+	json.Unmarshal(data, &payload)
+	cof := payload.Subscription.PaymentType.Credit.CredentialsOnFile
+	if cof.Type != Recurring || cof.Initiator != InitiatorMerchant {
+		t.Errorf("Expected '%s' by '%s', got '%s' by '%s'", Recurring, InitiatorMerchant, cof.Type, cof.Initiator)
+	}
+	if cof.TransactionID != "1002217281190421" {
+		t.Errorf("Expected '%s', got '%s'", "1002217281190421", cof.TransactionID)
+	}
+}
+
+func TestSubscriptionCredentialsOnFileCardholder(t *testing.T) {
+	s := Subscription{Card: Card{NumberToken: numberToken}}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	var payload struct {
+		Subscription struct {
+			PaymentType struct {
+				Credit struct {
+					CredentialsOnFile CredentialsOnFile `json:"credentials_on_file"`
+				} `json:"credit"`
+			} `json:"payment_type"`
+		} `json:"subscription"`
+	}
+	json.Unmarshal(data, &payload)
+	cof := payload.Subscription.PaymentType.Credit.CredentialsOnFile
+	if cof.Type != Recurring || cof.Initiator != InitiatorCardholder {
+		t.Errorf("Expected '%s' by '%s', got '%s' by '%s'", Recurring, InitiatorCardholder, cof.Type, cof.Initiator)
+	}
+	if cof.TransactionID != "" {
+		t.Errorf("Expected no transaction id, got '%s'", cof.TransactionID)
+	}
+	if err := s.credentialsOnFile().validate(); err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+}
@@ -0,0 +1,116 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+const (
+	endpointCustomers = "/v1/customers"
+)
+
+func (c Customer) Register(cc ClientCredentials) (Customer, error) {
+	return c.RegisterContext(context.Background(), cc)
+}
+
+func (c Customer) RegisterContext(ctx context.Context, cc ClientCredentials) (Customer, error) {
+	payload := struct {
+		SellerID string `json:"seller_id,omitempty"`
+		Customer
+		BillingAddress *BillingAddress `json:"billing_address,omitempty"`
+		Address        BillingAddress  `json:"address"`
+	}{
+		SellerID: cc.SellerID,
+		Customer: c,
+		Address:  c.BillingAddress,
+	}
+
+	res, err := NewRestClient(cc).WithContext(ctx).Post(endpointCustomers, payload)
+	if err != nil {
+		return Customer{}, err
+	}
+
+	var rc registeredCustomer
+	err = json.Unmarshal(res.Body, &rc)
+	return rc.customer(), err
+}
+
+func GetCustomer(cc ClientCredentials, customerID string) (Customer, error) {
+	return GetCustomerContext(context.Background(), cc, customerID)
+}
+
+func GetCustomerContext(ctx context.Context, cc ClientCredentials, customerID string) (Customer, error) {
+	res, err := NewRestClient(cc).WithContext(ctx).Get(endpointCustomers + "/" + url.PathEscape(customerID))
+	if err != nil {
+		return Customer{}, err
+	}
+
+	var rc registeredCustomer
+	err = json.Unmarshal(res.Body, &rc)
+	return rc.customer(), err
+}
+
+func ListCustomers(cc ClientCredentials, f CustomerFilter) (CustomerList, error) {
+	return ListCustomersContext(context.Background(), cc, f)
+}
+
+func ListCustomersContext(ctx context.Context, cc ClientCredentials, f CustomerFilter) (CustomerList, error) {
+	res, err := NewRestClient(cc).WithContext(ctx).Get(endpointCustomers + "?" + f.query().Encode())
+	if err != nil {
+		return CustomerList{}, err
+	}
+
+	var list struct {
+		Paging
+		Customers []registeredCustomer `json:"customers"`
+	}
+	if err := json.Unmarshal(res.Body, &list); err != nil {
+		return CustomerList{}, err
+	}
+
+	cl := CustomerList{Paging: list.Paging}
+	for _, rc := range list.Customers {
+		cl.Customers = append(cl.Customers, rc.customer())
+	}
+	return cl, nil
+}
+
+type CustomerFilter struct {
+	Page           int
+	Limit          int
+	CustomerID     string
+	FirstName      string
+	LastName       string
+	DocumentNumber string
+	SortType       string
+}
+
+func (f CustomerFilter) query() url.Values {
+	query := url.Values{}
+	addPaging(query, f.Page, f.Limit)
+	addQuery(query, "customer_id", f.CustomerID)
+	addQuery(query, "first_name", f.FirstName)
+	addQuery(query, "last_name", f.LastName)
+	addQuery(query, "document_number", f.DocumentNumber)
+	addQuery(query, "sort_type", f.SortType)
+	return query
+}
+
+type CustomerList struct {
+	Paging
+	Customers []Customer
+}
+
+type registeredCustomer struct {
+	Customer
+	Address BillingAddress `json:"address"`
+}
+
+func (rc registeredCustomer) customer() Customer {
+	c := rc.Customer
+	if c.BillingAddress == (BillingAddress{}) {
+		c.BillingAddress = rc.Address
+	}
+	return c
+}
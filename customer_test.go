@@ -0,0 +1,132 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCustomerRegister(t *testing.T) {
+	server := serverTestCustomers()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	c := Customer{
+		CustomerID:     customerID,
+		FirstName:      "João",
+		LastName:       "da Silva",
+		DocumentType:   "CPF",
+		DocumentNumber: "12345678912",
+		BirthDate:      "1976-02-21",
+		CelphoneNumber: "5551999887766",
+		Observation:    "O pagamento tem acréscimo de 10%",
+		BillingAddress: BillingAddress{
+			Street: "Av. Brasil",
+			City:   "Porto Alegre",
+		},
+	}
+	registered, err := c.Register(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if registered.CustomerID != customerID {
+		t.Errorf("Expected '%s', got '%s'", customerID, registered.CustomerID)
+	}
+	if registered.BillingAddress.City != "Porto Alegre" {
+		t.Errorf("Expected '%s', got '%s'", "Porto Alegre", registered.BillingAddress.City)
+	}
+}
+
+func TestGetCustomer(t *testing.T) {
+	server := serverTestCustomers()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	c, err := GetCustomer(credentials, customerID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if c.BirthDate != "1976-02-21" {
+		t.Errorf("Expected '%s', got '%s'", "1976-02-21", c.BirthDate)
+	}
+}
+
+func TestListCustomers(t *testing.T) {
+	server := serverTestCustomers()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cl, err := ListCustomers(credentials, CustomerFilter{Page: 1, Limit: 1})
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(cl.Customers) != 1 {
+		t.Fatalf("Expected 1 customer, got %d", len(cl.Customers))
+	}
+	if cl.Customers[0].CelphoneNumber != "5551999887766" {
+		t.Errorf("Expected '%s', got '%s'", "5551999887766", cl.Customers[0].CelphoneNumber)
+	}
+	if !cl.HasNext() {
+		t.Errorf("Expected next page")
+	}
+}
+
+func serverTestCustomers() *httptest.Server {
+	customer := fmt.Sprintf(`{
+"customer_id": "%s",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"first_name": "João",
+"last_name": "da Silva",
+"document_type": "CPF",
+"document_number": "12345678912",
+"birth_date": "1976-02-21",
+"celphone_number": "5551999887766",
+"observation": "O pagamento tem acréscimo de 10%%",
+"address": {
+  "street": "Av. Brasil",
+  "city": "Porto Alegre"
+}
+}`, customerID)
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/customers":
+			var payload map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&payload)
+			if _, ok := payload["address"]; !ok {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if _, ok := payload["billing_address"]; ok {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write([]byte(customer))
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customers":
+			if req.URL.Query().Get("limit") != "1" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, `{"page": 1, "limit": 1, "total": 2, "customers": [%s]}`, customer)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/customers/"+customerID:
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(customer))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
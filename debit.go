@@ -0,0 +1,105 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	endpointPaymentDebit         = "/v1/payments/debit"
+	endpointPaymentDebitFinalize = "/v1/payments/debit/%s/authenticated/finalize"
+)
+
+func (p Payment) PayDebit(c ClientCredentials) (PaymentResponse, error) {
+	return p.PayDebitContext(context.Background(), c)
+}
+
+func (p Payment) PayDebitContext(ctx context.Context, c ClientCredentials) (PaymentResponse, error) {
+	if p.Currency == "" {
+		p.Currency = RealBrazilian
+	}
+	p.Credit = Credit{}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointPaymentDebit, p)
+	if err != nil {
+		return PaymentResponse{}, err
+	}
+
+	var pr PaymentResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
+
+func (p PaymentResponse) FinalizeDebit(c ClientCredentials, payerAuthenticationResponse string) (PaymentResponse, error) {
+	return p.FinalizeDebitContext(context.Background(), c, payerAuthenticationResponse)
+}
+
+func (p PaymentResponse) FinalizeDebitContext(ctx context.Context, c ClientCredentials, payerAuthenticationResponse string) (PaymentResponse, error) {
+	payload := struct {
+		PayerAuthenticationResponse string `json:"payer_authentication_response"`
+	}{
+		PayerAuthenticationResponse: payerAuthenticationResponse,
+	}
+
+	if p.PaymentID == "" {
+		return PaymentResponse{}, errPaymentID
+	}
+	endpoint := fmt.Sprintf(endpointPaymentDebitFinalize, url.PathEscape(p.PaymentID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, payload)
+	if err != nil {
+		return PaymentResponse{}, err
+	}
+
+	var pr PaymentResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
+
+func (p PaymentResponse) RequiresAuthentication() bool {
+	return p.RedirectURL != ""
+}
+
+type PostData struct {
+	IssuerPaymentID            string `json:"issuer_payment_id"`
+	PayerAuthenticationRequest string `json:"payer_authentication_request"`
+}
+
+// Form retorna os campos que devem ser enviados via POST para a redirect_url
+// do emissor, que devolverá o comprador para termURL.
+func (pd PostData) Form(termURL string) url.Values {
+	form := url.Values{}
+	form.Add("MD", pd.IssuerPaymentID)
+	form.Add("PaReq", pd.PayerAuthenticationRequest)
+	form.Add("TermUrl", termURL)
+	return form
+}
+
+type DebitResponse struct {
+	AuthorizationCode     string    `json:"authorization_code"`
+	AuthorizedAt          time.Time `json:"authorized_at"`
+	ReasonCode            string    `json:"reason_code"`
+	ReasonMessage         string    `json:"reason_message"`
+	Acquirer              string    `json:"acquirer"`
+	SoftDescriptor        string    `json:"soft_descriptor"`
+	Brand                 Brand     `json:"brand"`
+	TerminalNSU           string    `json:"terminal_nsu"`
+	AcquirerTransactionID string    `json:"acquirer_transaction_id"`
+	TransactionID         string    `json:"transaction_id"`
+}
+
+func (dr *DebitResponse) UnmarshalJSON(data []byte) error {
+	type Alias DebitResponse
+	aux := &struct {
+		*Alias
+		AuthorizedAt string `json:"authorized_at"`
+	}{
+		Alias: (*Alias)(dr),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	dr.AuthorizedAt, _ = time.Parse("2006-01-02T15:04:05Z", aux.AuthorizedAt)
+	return nil
+}
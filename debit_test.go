@@ -0,0 +1,148 @@
+package getnet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	payerAuthenticationRequest  = "eNpVUtFugzAM/BXEeyEJhVVbmqpapa5S1SGo9p6CV9BKoEmY2N8vAbq1T/..."
+	payerAuthenticationResponse = "eNrNWFmTqsoSfvdXGOe8GN4/..."
+)
+
+func TestPaymentDebit(t *testing.T) {
+	server := serverTestPaymentDebit()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := Payment{
+		Amount: 1.23,
+		Debit: Debit{
+			CardHolderMobile: "5551999887766",
+			Card:             Card{NumberToken: numberToken},
+		},
+	}
+	pr, err := p.PayDebit(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.RequiresAuthentication() {
+		t.Errorf("Expected a redirect_url, got '%s'", pr.RedirectURL)
+	}
+	if pr.PostData.PayerAuthenticationRequest != payerAuthenticationRequest {
+		t.Errorf("Expected '%s', got '%s'", payerAuthenticationRequest, pr.PostData.PayerAuthenticationRequest)
+	}
+
+	form := pr.PostData.Form("https://loja.com.br/retorno")
+	if form.Get("PaReq") != payerAuthenticationRequest {
+		t.Errorf("Expected '%s', got '%s'", payerAuthenticationRequest, form.Get("PaReq"))
+	}
+
+	pr, err = pr.FinalizeDebit(credentials, payerAuthenticationResponse)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", pr.Status)
+	}
+
+	expected := "2017-03-19 16:30:30 +0000 UTC"
+	if pr.Debit.AuthorizedAt.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, pr.Debit.AuthorizedAt.String())
+	}
+}
+
+func TestPaymentMarshalOmitsUnusedMethod(t *testing.T) {
+	p := Payment{Debit: Debit{Card: Card{NumberToken: numberToken}}}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	var payload map[string]interface{}
+	json.Unmarshal(data, &payload)
+	if _, ok := payload["credit"]; ok {
+		t.Errorf("Expected no credit in debit payment, got '%s'", data)
+	}
+	if _, ok := payload["debit"]; !ok {
+		t.Errorf("Expected debit in debit payment, got '%s'", data)
+	}
+}
+
+func serverTestPaymentDebit() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch req.URL.Path {
+		case "/v1/payments/debit":
+			body, _ := ioutil.ReadAll(req.Body)
+			var payload map[string]interface{}
+			json.Unmarshal(body, &payload)
+			if _, ok := payload["credit"]; ok {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			rw.WriteHeader(http.StatusCreated)
+			json.NewEncoder(rw).Encode(map[string]interface{}{
+				"payment_id":   paymentID,
+				"amount":       123,
+				"currency":     "BRL",
+				"status":       "PENDING",
+				"redirect_url": "https://acs.emissor.com.br/pareq",
+				"post_data": map[string]string{
+					"issuer_payment_id":            "MTIzNDU2Nzg5MDEyMzQ1Njc4OTA=",
+					"payer_authentication_request": payerAuthenticationRequest,
+				},
+			})
+		case "/v1/payments/debit/" + paymentID + "/authenticated/finalize":
+			var payload struct {
+				PayerAuthenticationResponse string `json:"payer_authentication_response"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			if payload.PayerAuthenticationResponse != payerAuthenticationResponse {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			rw.WriteHeader(http.StatusOK)
+			payloadResponse := `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"amount": 123,
+"currency": "BRL",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"status": "APPROVED",
+"debit": {
+  "authorization_code": "000000099999",
+  "authorized_at": "2017-03-19T16:30:30Z",
+  "reason_code": "0",
+  "reason_message": "transaction approved",
+  "acquirer": "GETNET",
+  "soft_descriptor": "Descrição para fatura",
+  "brand": "Mastercard",
+  "terminal_nsu": "0099999",
+  "acquirer_transaction_id": "10000024",
+  "transaction_id": "1002217281190421"
+}
+}`
+			rw.Write([]byte(payloadResponse))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestFinalizeDebitPaymentID(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	_, err := PaymentResponse{}.FinalizeDebit(credentials, payerAuthenticationResponse)
+	if err != errPaymentID {
+		t.Errorf("Expected '%s', got '%v'", errPaymentID, err)
+	}
+}
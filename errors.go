@@ -0,0 +1,142 @@
+package getnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// APIError representa uma resposta de erro da API da Getnet, preservando o
+// status HTTP, os campos dos esquemas V1 e V2 e o corpo original.
+type APIError struct {
+	StatusCode int
+
+	// Esquema V1 (/v1/...)
+	Message string
+	Name    string
+	Details []Detail
+
+	// Esquema V2/OAuth (autenticação) ou fault do gateway
+	Code        string
+	Description string
+
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	if msg := (ErrorResponseSchemaV1{Details: e.Details}).String(); msg != "" {
+		return msg
+	}
+	if e.Description != "" {
+		return e.Description
+	}
+	if e.Message != "" {
+		return e.Message
+	}
+	msg := fmt.Sprintf("HTTP %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if b := excerpt(e.Body); b != "" {
+		msg += ": " + b
+	}
+	return msg
+}
+
+// ErrorCodes retorna os códigos de erro (error_code) de todos os detalhes.
+func (e *APIError) ErrorCodes() []string {
+	var codes []string
+	for _, d := range e.Details {
+		codes = append(codes, d.ErroCode)
+	}
+	return codes
+}
+
+// errorPayload reúne os formatos de erro conhecidos: esquema V1 (message,
+// name, details), esquema V2/OAuth (error, error_description) e o fault
+// retornado pelo gateway.
+type errorPayload struct {
+	ErrorResponseSchemaV1
+	ErrorResponseSchemaV2
+	Fault *struct {
+		FaultString string `json:"faultstring"`
+		Detail      struct {
+			ErrorCode string `json:"errorcode"`
+		} `json:"detail"`
+	} `json:"fault"`
+}
+
+func decodeError(statusCode int, contentType string, body []byte) *APIError {
+	e := &APIError{StatusCode: statusCode, Body: body}
+
+	trimmed := bytes.TrimSpace(body)
+	if strings.Contains(contentType, "html") || !bytes.HasPrefix(trimmed, []byte("{")) {
+		return e
+	}
+
+	var p errorPayload
+	if err := json.Unmarshal(trimmed, &p); err != nil {
+		return e
+	}
+	e.Message = p.Message
+	e.Name = p.Name
+	e.Details = p.Details
+	e.Code = p.Error
+	e.Description = p.Description
+	if p.Fault != nil {
+		if e.Code == "" {
+			e.Code = p.Fault.Detail.ErrorCode
+		}
+		if e.Description == "" {
+			e.Description = p.Fault.FaultString
+		}
+	}
+	return e
+}
+
+// IsUnauthorized indica falha de autenticação: credenciais inválidas ou token
+// de acesso expirado.
+func IsUnauthorized(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.StatusCode == http.StatusUnauthorized
+}
+
+// IsValidation indica que a requisição foi recusada por dados inválidos.
+func IsValidation(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) &&
+		(e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity)
+}
+
+// IsDenied indica que a transação foi negada pelo emissor ou pelo antifraude.
+func IsDenied(err error) bool {
+	var e *APIError
+	if !errors.As(err, &e) {
+		return false
+	}
+	if e.StatusCode == http.StatusPaymentRequired {
+		return true
+	}
+	for _, d := range e.Details {
+		if strings.EqualFold(d.Status, PaymentDenied) {
+			return true
+		}
+	}
+	return false
+}
+
+const maxExcerpt = 200
+
+var htmlTag = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]*>`)
+
+// excerpt resume o corpo da resposta para a mensagem de erro, removendo tags
+// HTML e espaços repetidos.
+func excerpt(body []byte) string {
+	s := htmlTag.ReplaceAllString(string(body), " ")
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > maxExcerpt {
+		s = string(r[:maxExcerpt]) + "..."
+	}
+	return s
+}
@@ -0,0 +1,185 @@
+package getnet
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorV1(t *testing.T) {
+	errResponse := ErrorResponseSchemaV1{
+		Message: "Payment Required",
+		Name:    "PaymentDenied",
+		Details: []Detail{{
+			Status:            PaymentDenied,
+			ErroCode:          "PAYMENTS-402",
+			Description:       "Transação negada.",
+			DescriptionDetail: "Transação não autorizada pelo emissor."}}}
+	server := serverTestAPIError(http.StatusPaymentRequired, errResponse)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := GetPayment(fixtureCredentials(), paymentID)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got '%T'", err)
+	}
+	if apiErr.StatusCode != http.StatusPaymentRequired {
+		t.Errorf("Expected '%d', got '%d'", http.StatusPaymentRequired, apiErr.StatusCode)
+	}
+	if apiErr.Name != errResponse.Name {
+		t.Errorf("Expected '%s', got '%s'", errResponse.Name, apiErr.Name)
+	}
+	if codes := apiErr.ErrorCodes(); len(codes) != 1 || codes[0] != "PAYMENTS-402" {
+		t.Errorf("Expected '%s', got '%v'", "PAYMENTS-402", codes)
+	}
+	if len(apiErr.Body) == 0 {
+		t.Errorf("Expected raw body")
+	}
+	if err.Error() != "Transação não autorizada pelo emissor." {
+		t.Errorf("Expected '%s', got '%s'", "Transação não autorizada pelo emissor.", err.Error())
+	}
+	if !IsDenied(err) {
+		t.Errorf("Expected denied error")
+	}
+	if IsUnauthorized(err) || IsValidation(err) {
+		t.Errorf("Expected only denied error")
+	}
+}
+
+func TestAPIErrorV2(t *testing.T) {
+	errResponse := ErrorResponseSchemaV2{
+		Error:       "invalid_client",
+		Description: "Não autorizado."}
+	server := serverTestAPIError(http.StatusUnauthorized, errResponse)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := fixtureCredentials().NewAccessToken()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got '%T'", err)
+	}
+	if apiErr.Code != errResponse.Error {
+		t.Errorf("Expected '%s', got '%s'", errResponse.Error, apiErr.Code)
+	}
+	if err.Error() != errResponse.Description {
+		t.Errorf("Expected '%s', got '%s'", errResponse.Description, err.Error())
+	}
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error")
+	}
+}
+
+func TestAPIErrorValidation(t *testing.T) {
+	errResponse := ErrorResponseSchemaV1{
+		Message: "Bad Request",
+		Name:    "ValidationError"}
+	server := serverTestAPIError(http.StatusBadRequest, errResponse)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := GetPayment(fixtureCredentials(), paymentID)
+	if !IsValidation(err) {
+		t.Errorf("Expected validation error")
+	}
+	if err.Error() != errResponse.Message {
+		t.Errorf("Expected '%s', got '%s'", errResponse.Message, err.Error())
+	}
+	if IsDenied(errors.New("Bad Request")) {
+		t.Errorf("Expected non API error not to be denied")
+	}
+}
+
+func TestAPIErrorGatewayHTML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/html")
+		rw.WriteHeader(http.StatusBadGateway)
+		rw.Write([]byte("<html><head><title>502</title><style>h1{}</style></head>\n<body><h1>502 Bad Gateway</h1></body></html>"))
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := GetPayment(fixtureCredentials(), paymentID)
+	expected := "HTTP 502 Bad Gateway: 502 502 Bad Gateway"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', got '%v'", expected, err)
+	}
+}
+
+func TestAPIErrorEmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := GetPayment(fixtureCredentials(), paymentID)
+	expected := "HTTP 502 Bad Gateway"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', got '%v'", expected, err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected *APIError with status '%d', got '%v'", http.StatusBadGateway, err)
+	}
+}
+
+func TestAPIErrorSchemaSniffing(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected string
+		code     string
+	}{
+		{`{"error":"invalid_token","error_description":"Token expirado."}`, "Token expirado.", "invalid_token"},
+		{`{"fault":{"faultstring":"Rate limit quota violation","detail":{"errorcode":"policies.ratelimit.QuotaViolation"}}}`, "Rate limit quota violation", "policies.ratelimit.QuotaViolation"},
+		{`{"message":"Endpoint request timed out"}`, "Endpoint request timed out", ""},
+		{`{"unexpected":true}`, `HTTP 504 Gateway Timeout: {"unexpected":true}`, ""},
+		{`upstream request timeout`, "HTTP 504 Gateway Timeout: upstream request timeout", ""},
+	}
+	for _, c := range cases {
+		err := decodeError(http.StatusGatewayTimeout, "application/json", []byte(c.body))
+		if err.Error() != c.expected {
+			t.Errorf("Expected '%s', got '%s'", c.expected, err.Error())
+		}
+		if err.Code != c.code {
+			t.Errorf("Expected '%s', got '%s'", c.code, err.Code)
+		}
+	}
+
+	// corpo V2 em endpoint /v1/ também é reconhecido
+	server := serverTestAPIError(http.StatusUnauthorized, ErrorResponseSchemaV2{Description: "Não autorizado."})
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := GetPayment(fixtureCredentials(), paymentID)
+	if err == nil || err.Error() != "Não autorizado." {
+		t.Errorf("Expected '%s', got '%v'", "Não autorizado.", err)
+	}
+}
+
+func TestAPIErrorExcerpt(t *testing.T) {
+	body := []byte(strings.Repeat("á", maxExcerpt+10))
+	e := excerpt(body)
+	if e != strings.Repeat("á", maxExcerpt)+"..." {
+		t.Errorf("Expected excerpt of %d runes, got '%s'", maxExcerpt, e)
+	}
+}
+
+func serverTestAPIError(status int, body interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(status)
+		json.NewEncoder(rw).Encode(body)
+	}))
+}
@@ -0,0 +1,116 @@
+package getnet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var errSplitAmount = errors.New("A soma dos valores dos subsellers (subseller_sales_amount) deve ser igual ao valor do pagamento (amount).")
+
+type SubsellerPayment struct {
+	SubsellerID          string      `json:"subseller_id"`
+	SubsellerSalesAmount float64     `json:"subseller_sales_amount"`
+	OrderItems           []OrderItem `json:"order_items"`
+}
+
+func (sp SubsellerPayment) MarshalJSON() ([]byte, error) {
+	type Alias SubsellerPayment
+	return json.Marshal(&struct {
+		Alias
+		SubsellerSalesAmount int `json:"subseller_sales_amount"`
+	}{
+		Alias:                (Alias)(sp),
+		SubsellerSalesAmount: toCents(sp.SubsellerSalesAmount),
+	})
+}
+
+func (sp *SubsellerPayment) UnmarshalJSON(data []byte) error {
+	type Alias SubsellerPayment
+	aux := &struct {
+		*Alias
+		SubsellerSalesAmount int `json:"subseller_sales_amount"`
+	}{
+		Alias: (*Alias)(sp),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	sp.SubsellerSalesAmount = fromCents(aux.SubsellerSalesAmount)
+	return nil
+}
+
+type OrderItem struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description,omitempty"`
+	Amount      float64  `json:"amount"`
+	Currency    Currency `json:"currency"`
+	TaxAmount   float64  `json:"tax_amount,omitempty"`
+}
+
+func (oi OrderItem) MarshalJSON() ([]byte, error) {
+	type Alias OrderItem
+	return json.Marshal(&struct {
+		Alias
+		Amount    int `json:"amount"`
+		TaxAmount int `json:"tax_amount,omitempty"`
+	}{
+		Alias:     (Alias)(oi),
+		Amount:    toCents(oi.Amount),
+		TaxAmount: toCents(oi.TaxAmount),
+	})
+}
+
+func (oi *OrderItem) UnmarshalJSON(data []byte) error {
+	type Alias OrderItem
+	aux := &struct {
+		*Alias
+		Amount    int `json:"amount"`
+		TaxAmount int `json:"tax_amount"`
+	}{
+		Alias: (*Alias)(oi),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	oi.Amount = fromCents(aux.Amount)
+	oi.TaxAmount = fromCents(aux.TaxAmount)
+	return nil
+}
+
+func (p Payment) validateSplit() error {
+	if len(p.MarketplaceSubsellerPayments) == 0 {
+		return nil
+	}
+
+	var total int
+	for _, sp := range p.MarketplaceSubsellerPayments {
+		amount := toCents(sp.SubsellerSalesAmount)
+		total += amount
+
+		if len(sp.OrderItems) == 0 {
+			continue
+		}
+		var items int
+		for _, oi := range sp.OrderItems {
+			items += toCents(oi.Amount)
+		}
+		if items != amount {
+			return fmt.Errorf("A soma dos itens do subseller %s deve ser igual ao seu valor (subseller_sales_amount).", sp.SubsellerID)
+		}
+	}
+
+	if total != toCents(p.Amount) {
+		return errSplitAmount
+	}
+	return nil
+}
+
+func (p PaymentResponse) Subseller(subsellerID string) (SubsellerPayment, bool) {
+	for _, sp := range p.MarketplaceSubsellerPayments {
+		if sp.SubsellerID == subsellerID {
+			return sp, true
+		}
+	}
+	return SubsellerPayment{}, false
+}
@@ -0,0 +1,119 @@
+package getnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentMarketplaceSplit(t *testing.T) {
+	server := serverTestPaymentMarketplace()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := fixtureMarketplacePayment()
+	pr, err := p.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(pr.MarketplaceSubsellerPayments) != 2 {
+		t.Fatalf("Expected 2 subseller payments, got %d", len(pr.MarketplaceSubsellerPayments))
+	}
+
+	sp, ok := pr.Subseller("700000001")
+	if !ok {
+		t.Fatalf("Expected subseller '%s'", "700000001")
+	}
+	if sp.SubsellerSalesAmount != 7.5 {
+		t.Errorf("Expected '%f', got '%f'", 7.5, sp.SubsellerSalesAmount)
+	}
+	if sp.OrderItems[0].Amount != 7.5 {
+		t.Errorf("Expected '%f', got '%f'", 7.5, sp.OrderItems[0].Amount)
+	}
+}
+
+func TestPaymentMarketplaceSplitAmount(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	p := fixtureMarketplacePayment()
+	p.Amount = 20
+	_, err := p.Pay(credentials)
+	if err != errSplitAmount {
+		t.Errorf("Expected '%s', got '%v'", errSplitAmount, err)
+	}
+}
+
+func TestPaymentMarketplaceSplitItems(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	p := fixtureMarketplacePayment()
+	p.MarketplaceSubsellerPayments[1].OrderItems[0].Amount = 1
+	_, err := p.Pay(credentials)
+
+	expected := "A soma dos itens do subseller 700000002 deve ser igual ao seu valor (subseller_sales_amount)."
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', got '%v'", expected, err)
+	}
+}
+
+func fixtureMarketplacePayment() Payment {
+	return Payment{
+		Amount: 10.1,
+		MarketplaceSubsellerPayments: []SubsellerPayment{
+			{
+				SubsellerID:          "700000001",
+				SubsellerSalesAmount: 7.5,
+				OrderItems: []OrderItem{
+					{ID: "1", Amount: 7.5, Currency: RealBrazilian},
+				},
+			},
+			{
+				SubsellerID:          "700000002",
+				SubsellerSalesAmount: 2.6,
+				OrderItems: []OrderItem{
+					{ID: "2", Amount: 2.5, Currency: RealBrazilian},
+					{ID: "3", Amount: 0.1, Currency: RealBrazilian},
+				},
+			},
+		},
+	}
+}
+
+func serverTestPaymentMarketplace() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		var p struct {
+			Amount                       int `json:"amount"`
+			MarketplaceSubsellerPayments []struct {
+				SubsellerID          string `json:"subseller_id"`
+				SubsellerSalesAmount int    `json:"subseller_sales_amount"`
+				OrderItems           []struct {
+					Amount int `json:"amount"`
+				} `json:"order_items"`
+			} `json:"marketplace_subseller_payments"`
+		}
+		json.NewDecoder(req.Body).Decode(&p)
+		if p.Amount != 1010 || len(p.MarketplaceSubsellerPayments) != 2 ||
+			p.MarketplaceSubsellerPayments[0].SubsellerSalesAmount != 750 {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		rw.WriteHeader(http.StatusCreated)
+		payload := `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"amount": 1010,
+"currency": "BRL",
+"status": "APPROVED",
+"marketplace_subseller_payments": [
+  {"subseller_id": "700000001", "subseller_sales_amount": 750, "order_items": [{"id": "1", "amount": 750, "currency": "BRL"}]},
+  {"subseller_id": "700000002", "subseller_sales_amount": 260, "order_items": [{"id": "2", "amount": 250, "currency": "BRL"}, {"id": "3", "amount": 10, "currency": "BRL"}]}
+]
+}`
+		rw.Write([]byte(payload))
+	}))
+}
@@ -0,0 +1,236 @@
+package getnet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const maxNotificationSize = 64 << 10
+
+var (
+	errPaymentType         = errors.New("Notificação sem o tipo de pagamento (payment_type).")
+	errNotificationPayload = errors.New("Campo da notificação em formato não suportado (lista ou objeto aninhado).")
+)
+
+type PaymentType string
+
+type NotificationFunc func(Notification) error
+
+const (
+	// Tipo de pagamento notificado (payment_type)
+	PaymentTypeCredit     PaymentType = "credit"
+	PaymentTypeDebit      PaymentType = "debit"
+	PaymentTypeBoleto     PaymentType = "boleto"
+	PaymentTypePix        PaymentType = "pix"
+	PaymentTypeRecurrence PaymentType = "recurrence"
+)
+
+type Notification struct {
+	PaymentType            PaymentType
+	PaymentID              string
+	OrderID                string
+	CustomerID             string
+	Amount                 float64
+	Status                 string
+	NumberInstallments     int
+	Brand                  Brand
+	AuthorizationCode      string
+	AuthorizationTimestamp time.Time
+	AcquirerTransactionID  string
+	TerminalNSU            string
+	TransactionID          string
+	DescriptionDetail      string
+	ErrorCode              string
+
+	// Boleto
+	BoletoID    string
+	OurNumber   string
+	TypefulLine string
+
+	// Recorrência
+	SubscriptionID string
+	ChargeID       string
+	PlanID         string
+}
+
+func (n Notification) Approved() bool {
+	return n.Status == PaymentApproved
+}
+
+func (n Notification) Denied() bool {
+	return n.Status == PaymentDenied
+}
+
+func (n Notification) Canceled() bool {
+	return n.Status == PaymentCanceled
+}
+
+func (n Notification) Paid() bool {
+	return n.Status == PaymentPaid
+}
+
+func (n Notification) Recurrent() bool {
+	return n.SubscriptionID != "" || n.ChargeID != ""
+}
+
+func ParseNotification(req *http.Request) (Notification, error) {
+	values, err := notificationValues(req)
+	if err != nil {
+		return Notification{}, err
+	}
+	if values.Get("payment_type") == "" {
+		return Notification{}, errPaymentType
+	}
+
+	amount, _ := strconv.Atoi(values.Get("amount"))
+	installments, _ := strconv.Atoi(values.Get("number_installments"))
+	return Notification{
+		PaymentType:            PaymentType(values.Get("payment_type")),
+		PaymentID:              values.Get("payment_id"),
+		OrderID:                values.Get("order_id"),
+		CustomerID:             values.Get("customer_id"),
+		Amount:                 fromCents(amount),
+		Status:                 values.Get("status"),
+		NumberInstallments:     installments,
+		Brand:                  Brand(values.Get("brand")),
+		AuthorizationCode:      values.Get("authorization_code"),
+		AuthorizationTimestamp: parseDate(values.Get("authorization_timestamp")),
+		AcquirerTransactionID:  values.Get("acquirer_transaction_id"),
+		TerminalNSU:            values.Get("terminal_nsu"),
+		TransactionID:          values.Get("transaction_id"),
+		DescriptionDetail:      values.Get("description_detail"),
+		ErrorCode:              values.Get("error_code"),
+		BoletoID:               values.Get("boleto_id"),
+		OurNumber:              values.Get("our_number"),
+		TypefulLine:            values.Get("typeful_line"),
+		SubscriptionID:         values.Get("subscription_id"),
+		ChargeID:               values.Get("charge_id"),
+		PlanID:                 values.Get("plan_id"),
+	}, nil
+}
+
+func notificationValues(req *http.Request) (url.Values, error) {
+	if req.Method == http.MethodGet {
+		return req.URL.Query(), nil
+	}
+
+	// A URL de notificação é pública: o corpo é limitado antes da leitura.
+	req.Body = http.MaxBytesReader(nil, req.Body, maxNotificationSize)
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-type"))
+	if mediaType != "application/json" {
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		return req.Form, nil
+	}
+
+	var payload map[string]interface{}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(payload))
+	for k := range payload {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := req.URL.Query()
+	for _, k := range keys {
+		if _, ok := payload[k].(map[string]interface{}); ok {
+			continue
+		}
+		if err := setNotificationValue(values, k, payload[k]); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+	// Objetos aninhados são achatados em um nível, sem sobrescrever os campos
+	// de primeiro nível; listas e níveis mais profundos são recusados.
+	for _, k := range keys {
+		nested, ok := payload[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for nk, nv := range nested {
+			if values.Get(nk) != "" {
+				continue
+			}
+			if err := setNotificationValue(values, nk, nv); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", k, nk, err)
+			}
+		}
+	}
+	return values, nil
+}
+
+func setNotificationValue(values url.Values, k string, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+	case string:
+		values.Set(k, v)
+	case float64:
+		values.Set(k, strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		values.Set(k, strconv.FormatBool(v))
+	default:
+		return errNotificationPayload
+	}
+	return nil
+}
+
+type NotificationHandler struct {
+	callbacks map[PaymentType]NotificationFunc
+}
+
+func NewNotificationHandler() *NotificationHandler {
+	return &NotificationHandler{
+		callbacks: map[PaymentType]NotificationFunc{}}
+}
+
+func (h *NotificationHandler) Handle(t PaymentType, fn NotificationFunc) *NotificationHandler {
+	h.callbacks[t] = fn
+	return h
+}
+
+func (h *NotificationHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	n, err := ParseNotification(req)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fn, ok := h.callback(n)
+	if !ok {
+		rw.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// A Getnet reenvia a notificação enquanto não receber um status 200.
+	if err := fn(n); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (h *NotificationHandler) callback(n Notification) (NotificationFunc, bool) {
+	if n.Recurrent() {
+		if fn, ok := h.callbacks[PaymentTypeRecurrence]; ok {
+			return fn, true
+		}
+	}
+	fn, ok := h.callbacks[n.PaymentType]
+	return fn, ok
+}
@@ -0,0 +1,171 @@
+package getnet
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNotificationHandlerCredit(t *testing.T) {
+	var got Notification
+	handler := NewNotificationHandler().
+		Handle(PaymentTypeCredit, func(n Notification) error {
+			got = n
+			return nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/callback?payment_type=credit&customer_id=ea05ba48-d193-4eb8-a4e9-c9cae1e3e2aa"+
+		"&order_id=6d2e4380-d8a3-4ccb-9138-c289182818a3&payment_id="+paymentID+
+		"&amount=123&status=APPROVED&number_installments=1&brand=Mastercard"+
+		"&authorization_timestamp=2017-03-19T16:30:30.000Z", nil)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Errorf("Expected '%d', got '%d'", http.StatusOK, rw.Code)
+	}
+	if got.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, got.PaymentID)
+	}
+	if !got.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", got.Status)
+	}
+	if got.Amount != 1.23 {
+		t.Errorf("Expected '%f', got '%f'", 1.23, got.Amount)
+	}
+
+	expected := "2017-03-19 16:30:30 +0000 UTC"
+	if got.AuthorizationTimestamp.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, got.AuthorizationTimestamp.String())
+	}
+}
+
+func TestNotificationHandlerPixJSON(t *testing.T) {
+	var got Notification
+	handler := NewNotificationHandler().
+		Handle(PaymentTypePix, func(n Notification) error {
+			got = n
+			return nil
+		})
+
+	body := `{"payment_type": "pix", "payment_id": "` + paymentID + `", "amount": 123, "status": "APPROVED", "transaction_id": "1002217281190421"}`
+	req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(body))
+	req.Header.Set("Content-type", "application/json")
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Errorf("Expected '%d', got '%d'", http.StatusOK, rw.Code)
+	}
+	if got.PaymentType != PaymentTypePix {
+		t.Errorf("Expected '%s', got '%s'", PaymentTypePix, got.PaymentType)
+	}
+	if got.Amount != 1.23 {
+		t.Errorf("Expected '%f', got '%f'", 1.23, got.Amount)
+	}
+}
+
+func TestNotificationHandlerNestedJSON(t *testing.T) {
+	var got Notification
+	handler := NewNotificationHandler().
+		Handle(PaymentTypeCredit, func(n Notification) error {
+			got = n
+			return nil
+		})
+
+	body := `{"payment_type": "credit", "order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"payment": {"payment_id": "` + paymentID + `", "amount": 123, "status": "APPROVED", "order_id": "ignored"}}`
+	req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(body))
+	req.Header.Set("Content-type", "application/json")
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Errorf("Expected '%d', got '%d'", http.StatusOK, rw.Code)
+	}
+	if got.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, got.PaymentID)
+	}
+	if !got.Approved() || got.Amount != 1.23 {
+		t.Errorf("Expected approved '%f', got '%s' '%f'", 1.23, got.Status, got.Amount)
+	}
+	if got.OrderID != "6d2e4380-d8a3-4ccb-9138-c289182818a3" {
+		t.Errorf("Expected '%s', got '%s'", "6d2e4380-d8a3-4ccb-9138-c289182818a3", got.OrderID)
+	}
+}
+
+func TestNotificationHandlerRejectedJSON(t *testing.T) {
+	var called bool
+	handler := NewNotificationHandler().
+		Handle(PaymentTypeCredit, func(n Notification) error {
+			called = true
+			return nil
+		})
+
+	bodies := map[string]string{
+		"array":     `{"payment_type": "credit", "payment_id": "` + paymentID + `", "items": [1, 2]}`,
+		"deep":      `{"payment_type": "credit", "payment": {"credit": {"status": "APPROVED"}}}`,
+		"too large": `{"payment_type": "credit", "description_detail": "` + strings.Repeat("a", maxNotificationSize) + `"}`,
+	}
+	for name, body := range bodies {
+		req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(body))
+		req.Header.Set("Content-type", "application/json")
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+
+		if rw.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected '%d', got '%d'", name, http.StatusBadRequest, rw.Code)
+		}
+	}
+	if called {
+		t.Errorf("Expected callback not to be called")
+	}
+}
+
+func TestNotificationHandlerRecurrence(t *testing.T) {
+	var recurrent bool
+	handler := NewNotificationHandler().
+		Handle(PaymentTypeCredit, func(n Notification) error {
+			return nil
+		}).
+		Handle(PaymentTypeRecurrence, func(n Notification) error {
+			recurrent = n.SubscriptionID == subscriptionID
+			return nil
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/callback?payment_type=credit&status=APPROVED&subscription_id="+subscriptionID, nil)
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+
+	if !recurrent {
+		t.Errorf("Expected recurrence notification")
+	}
+}
+
+func TestNotificationHandlerStatusCodes(t *testing.T) {
+	handler := NewNotificationHandler().
+		Handle(PaymentTypeBoleto, func(n Notification) error {
+			return errors.New("indisponível")
+		})
+
+	tests := []struct {
+		method   string
+		target   string
+		expected int
+	}{
+		{http.MethodGet, "/callback?payment_type=boleto&status=PAID", http.StatusInternalServerError},
+		{http.MethodGet, "/callback?payment_type=debit&status=APPROVED", http.StatusOK},
+		{http.MethodGet, "/callback?status=APPROVED", http.StatusBadRequest},
+		{http.MethodPut, "/callback?payment_type=debit", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.target, nil)
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		if rw.Code != tt.expected {
+			t.Errorf("%s %s: expected '%d', got '%d'", tt.method, tt.target, tt.expected, rw.Code)
+		}
+	}
+}
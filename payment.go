@@ -1,7 +1,9 @@
 package getnet
 
 import (
+	"context"
 	"encoding/json"
+	"net/url"
 	"time"
 )
 
@@ -30,11 +32,14 @@ const (
 	InstallWithInterest TransactionType = "INSTALL_WITH_INTEREST"
 
 	// Status da transação (status)
+	PaymentPending    = "PENDING"
+	PaymentWaiting    = "WAITING"
 	PaymentCanceled   = "CANCELED"
 	PaymentApproved   = "APPROVED"
 	PaymentDenied     = "DENIED"
 	PaymentAuthorized = "AUTHORIZED"
 	PaymentConfirmed  = "CONFIRMED"
+	PaymentPaid       = "PAID"
 
 	RealBrazilian Currency = "BRL"
 	DollarUS      Currency = "USD"
@@ -50,20 +55,59 @@ type Payment struct {
 	Shippings []Shipping `json:"shippings,omitempty"`
 	Credit    Credit     `json:"credit,omitempty"`
 	Debit     Debit      `json:"debit,omitempty"`
+	Boleto    Boleto     `json:"boleto,omitempty"`
+
+	MarketplaceSubsellerPayments []SubsellerPayment `json:"marketplace_subseller_payments,omitempty"`
 }
 
 func (p Payment) MarshalJSON() ([]byte, error) {
 	type Alias Payment
 	return json.Marshal(&struct {
 		Alias
-		Amount int `json:"amount"`
+		Amount int     `json:"amount"`
+		Credit *Credit `json:"credit,omitempty"`
+		Debit  *Debit  `json:"debit,omitempty"`
+		Boleto *Boleto `json:"boleto,omitempty"`
 	}{
 		Alias:  (Alias)(p),
-		Amount: int(p.Amount * 100),
+		Amount: toCents(p.Amount),
+		Credit: p.credit(),
+		Debit:  p.debit(),
+		Boleto: p.boleto(),
 	})
 }
 
+func (p Payment) credit() *Credit {
+	if p.Credit == (Credit{}) {
+		return nil
+	}
+	return &p.Credit
+}
+
+func (p Payment) debit() *Debit {
+	if p.Debit == (Debit{}) {
+		return nil
+	}
+	return &p.Debit
+}
+
+func (p Payment) boleto() *Boleto {
+	if p.Boleto == (Boleto{}) {
+		return nil
+	}
+	return &p.Boleto
+}
+
 func (p Payment) Pay(c ClientCredentials) (PaymentResponse, error) {
+	return p.PayContext(context.Background(), c)
+}
+
+func (p Payment) PayContext(ctx context.Context, c ClientCredentials) (PaymentResponse, error) {
+	pr, _, err := p.pay(ctx, c)
+	return pr, err
+}
+
+func (p Payment) pay(ctx context.Context, c ClientCredentials) (PaymentResponse, Response, error) {
 	if p.Currency == "" {
 		p.Currency = RealBrazilian
 	}
@@ -73,7 +117,35 @@ func (p Payment) Pay(c ClientCredentials) (PaymentResponse, error) {
 	if p.Credit.NumberInstallments < 1 {
 		p.Credit.NumberInstallments = 1
 	}
-	res, err := NewRestClient(c).Post(endpointPaymentCredit, p)
+	if err := p.validateSplit(); err != nil {
+		return PaymentResponse{}, Response{}, err
+	}
+	if p.Credit.Wallet != nil {
+		if err := p.Credit.Wallet.validate(); err != nil {
+			return PaymentResponse{}, Response{}, err
+		}
+	}
+	if p.Credit.CredentialsOnFile != nil {
+		if err := p.Credit.CredentialsOnFile.validate(); err != nil {
+			return PaymentResponse{}, Response{}, err
+		}
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointPaymentCredit, p)
+	if err != nil {
+		return PaymentResponse{}, res, err
+	}
+
+	var pr PaymentResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, res, err
+}
+
+func GetPayment(c ClientCredentials, paymentID string) (PaymentResponse, error) {
+	return GetPaymentContext(context.Background(), c, paymentID)
+}
+
+func GetPaymentContext(ctx context.Context, c ClientCredentials, paymentID string) (PaymentResponse, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointPaymentCredit + "/" + url.PathEscape(paymentID))
 	if err != nil {
 		return PaymentResponse{}, err
 	}
@@ -83,6 +155,25 @@ func (p Payment) Pay(c ClientCredentials) (PaymentResponse, error) {
 	return pr, err
 }
 
+func GetPaymentsByOrderID(c ClientCredentials, orderID string) ([]PaymentResponse, error) {
+	return GetPaymentsByOrderIDContext(context.Background(), c, orderID)
+}
+
+func GetPaymentsByOrderIDContext(ctx context.Context, c ClientCredentials, orderID string) ([]PaymentResponse, error) {
+	query := url.Values{}
+	query.Add("order_id", orderID)
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointPaymentCredit + "?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Payments []PaymentResponse `json:"payments"`
+	}
+	err = json.Unmarshal(res.Body, &list)
+	return list.Payments, err
+}
+
 type Credit struct {
 	Delayed            bool            `json:"delayed"`
 	Authenticated      bool            `json:"authenticated"`
@@ -93,6 +184,10 @@ type Credit struct {
 	SoftDescriptor     string          `json:"soft_descriptor"`
 	DynamicMCC         int             `json:"dynamic_mcc"`
 	Card               Card            `json:"card"`
+	Authentication     *Authentication `json:"authentication,omitempty"`
+	Wallet             *Wallet         `json:"wallet,omitempty"`
+
+	CredentialsOnFile *CredentialsOnFile `json:"credentials_on_file,omitempty"`
 }
 
 func (c Credit) MarshalJSON() ([]byte, error) {
@@ -100,12 +195,21 @@ func (c Credit) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
 		Alias
 		SoftDescriptor string `json:"soft_descriptor,omitempty"`
+		Card           *Card  `json:"card,omitempty"`
 	}{
 		Alias:          (Alias)(c),
 		SoftDescriptor: maxLength(c.SoftDescriptor, 22),
+		Card:           c.card(),
 	})
 }
 
+func (c Credit) card() *Card {
+	if c.Wallet != nil && c.Card == (Card{}) {
+		return nil
+	}
+	return &c.Card
+}
+
 type Debit struct {
 	CardHolderMobile string `json:"cardholder_mobile"`
 	SoftDescriptor   string `json:"soft_descriptor"`
@@ -145,6 +249,9 @@ type Customer struct {
 	DocumentType   string         `json:"document_type,omitempty"`
 	DocumentNumber string         `json:"document_number,omitempty"`
 	PhoneNumber    string         `json:"phone_number,omitempty"`
+	CelphoneNumber string         `json:"celphone_number,omitempty"`
+	BirthDate      string         `json:"birth_date,omitempty"`
+	Observation    string         `json:"observation,omitempty"`
 	BillingAddress BillingAddress `json:"billing_address,omitempty"`
 }
 
@@ -175,7 +282,7 @@ func (s Shipping) MarshalJSON() ([]byte, error) {
 		ShippingAmount int `json:"shipping_amount"`
 	}{
 		Alias:          (Alias)(s),
-		ShippingAmount: int(s.ShippingAmount * 100),
+		ShippingAmount: toCents(s.ShippingAmount),
 	})
 }
 
@@ -191,14 +298,24 @@ type Address struct {
 }
 
 type PaymentResponse struct {
-	PaymentID  string         `json:"payment_id"`
-	SellerID   string         `json:"seller_id"`
-	Amount     float64        `json:"amount"`
-	Currency   Currency       `json:"currency"`
-	OrderID    string         `json:"order_id"`
-	Status     string         `json:"status"`
-	ReceivedAt time.Time      `json:"received_at"`
-	Credit     CreditResponse `json:"credit"`
+	PaymentID   string         `json:"payment_id"`
+	SellerID    string         `json:"seller_id"`
+	Amount      float64        `json:"amount"`
+	Currency    Currency       `json:"currency"`
+	OrderID     string         `json:"order_id"`
+	Status      string         `json:"status"`
+	ReceivedAt  time.Time      `json:"received_at"`
+	Credit      CreditResponse `json:"credit"`
+	Debit       DebitResponse  `json:"debit"`
+	Boleto      BoletoResponse `json:"boleto"`
+	RedirectURL string         `json:"redirect_url,omitempty"`
+	PostData    PostData       `json:"post_data,omitempty"`
+
+	MarketplaceSubsellerPayments []SubsellerPayment `json:"marketplace_subseller_payments,omitempty"`
+}
+
+func (p PaymentResponse) Pending() bool {
+	return p.Status == PaymentPending
 }
 
 func (p PaymentResponse) Canceled() bool {
@@ -233,23 +350,25 @@ func (p *PaymentResponse) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
-	p.Amount = float64(aux.Amount) / 100
+	p.Amount = fromCents(aux.Amount)
 	p.ReceivedAt, _ = time.Parse("2006-01-02T15:04:05.000Z", aux.ReceivedAt)
 	return nil
 }
 
 type CreditResponse struct {
-	Delayed               bool      `json:"delayed"`
-	AuthorizationCode     string    `json:"authorization_code"`
-	AuthorizedAt          time.Time `json:"authorized_at"`
-	ReasonCode            string    `json:"reason_code"`
-	ReasonMessage         string    `json:"reason_message"`
-	Acquirer              string    `json:"acquirer"`
-	SoftDescriptor        string    `json:"soft_descriptor"`
-	Brand                 Brand     `json:"brand"`
-	TerminalNSU           string    `json:"terminal_nsu"`
-	AcquirerTransactionID string    `json:"acquirer_transaction_id"`
-	TransactionID         string    `json:"transaction_id"`
+	Delayed                         bool       `json:"delayed"`
+	AuthorizationCode               string     `json:"authorization_code"`
+	AuthorizedAt                    time.Time  `json:"authorized_at"`
+	ReasonCode                      string     `json:"reason_code"`
+	ReasonMessage                   string     `json:"reason_message"`
+	Acquirer                        string     `json:"acquirer"`
+	SoftDescriptor                  string     `json:"soft_descriptor"`
+	Brand                           Brand      `json:"brand"`
+	TerminalNSU                     string     `json:"terminal_nsu"`
+	AcquirerTransactionID           string     `json:"acquirer_transaction_id"`
+	AdjustmentAcquirerTransactionID string     `json:"adjustment_acquirer_transaction_id,omitempty"`
+	TransactionID                   string     `json:"transaction_id"`
+	WalletType                      WalletType `json:"wallet_type,omitempty"`
 }
 
 func (cr *CreditResponse) UnmarshalJSON(data []byte) error {
@@ -41,6 +41,83 @@ func TestPaymentCredit(t *testing.T) {
 	}
 }
 
+func TestGetPayment(t *testing.T) {
+	server := serverTestPaymentCredit()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	pr, err := GetPayment(credentials, paymentID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if pr.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, pr.PaymentID)
+	}
+	if !pr.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", pr.Status)
+	}
+	if pr.Credit.TransactionID != "1002217281190421" {
+		t.Errorf("Expected '%s', got '%s'", "1002217281190421", pr.Credit.TransactionID)
+	}
+}
+
+func TestGetPaymentsByOrderID(t *testing.T) {
+	server := serverTestPaymentCredit()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	orderID := "6d2e4380-d8a3-4ccb-9138-c289182818a3"
+	payments, err := GetPaymentsByOrderID(credentials, orderID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(payments) != 1 {
+		t.Fatalf("Expected 1 payment, got %d", len(payments))
+	}
+	if payments[0].OrderID != orderID {
+		t.Errorf("Expected '%s', got '%s'", orderID, payments[0].OrderID)
+	}
+
+	payments, err = GetPaymentsByOrderID(credentials, "unknown")
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(payments) != 0 {
+		t.Errorf("Expected no payments, got %d", len(payments))
+	}
+}
+
+func TestPaymentAmountCents(t *testing.T) {
+	p := Payment{
+		Amount:    1.15,
+		Shippings: []Shipping{{ShippingAmount: 1.15}},
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	var payload struct {
+		Amount    int `json:"amount"`
+		Shippings []struct {
+			ShippingAmount int `json:"shipping_amount"`
+		} `json:"shippings"`
+	}
+	json.Unmarshal(data, &payload)
+	if payload.Amount != 115 {
+		t.Errorf("Expected '%d', got '%d'", 115, payload.Amount)
+	}
+	if len(payload.Shippings) != 1 || payload.Shippings[0].ShippingAmount != 115 {
+		t.Errorf("Expected '%d', got '%v'", 115, payload.Shippings)
+	}
+}
+
 func serverTestPaymentCredit() *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 
@@ -63,8 +140,26 @@ func serverTestPaymentCredit() *httptest.Server {
 			return
 		}
 
-		rw.WriteHeader(http.StatusCreated)
-		payload := `{
+		status := http.StatusCreated
+		if req.Method == http.MethodGet {
+			status = http.StatusOK
+		}
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/payments/credit" {
+			rw.WriteHeader(status)
+			if req.URL.Query().Get("order_id") != "6d2e4380-d8a3-4ccb-9138-c289182818a3" {
+				rw.Write([]byte(`{"payments": []}`))
+				return
+			}
+			rw.Write([]byte(`{"payments": [` + paymentCreditPayload + `]}`))
+			return
+		}
+
+		rw.WriteHeader(status)
+		rw.Write([]byte(paymentCreditPayload))
+	}))
+}
+
+const paymentCreditPayload = `{
 "payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
 "seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
 "amount": 123,
@@ -86,6 +181,3 @@ func serverTestPaymentCredit() *httptest.Server {
   "transaction_id": "1002217281190421"
 }
 }`
-		rw.Write([]byte(payload))
-	}))
-}
@@ -0,0 +1,120 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+const (
+	endpointPaymentPix = "/v1/payments/qrcode/pix"
+)
+
+type Pix struct {
+	Amount   float64  `json:"amount"`
+	Currency Currency `json:"currency"`
+	Order    Order    `json:"-"`
+	Customer Customer `json:"-"`
+}
+
+func (p Pix) MarshalJSON() ([]byte, error) {
+	type Alias Pix
+	return json.Marshal(&struct {
+		Alias
+		Amount     int    `json:"amount"`
+		OrderID    string `json:"order_id"`
+		CustomerID string `json:"customer_id"`
+	}{
+		Alias:      (Alias)(p),
+		Amount:     toCents(p.Amount),
+		OrderID:    p.Order.OrderID,
+		CustomerID: p.Customer.CustomerID,
+	})
+}
+
+func (p Pix) Pay(c ClientCredentials) (PixResponse, error) {
+	return p.PayContext(context.Background(), c)
+}
+
+func (p Pix) PayContext(ctx context.Context, c ClientCredentials) (PixResponse, error) {
+	if p.Currency == "" {
+		p.Currency = RealBrazilian
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointPaymentPix, p)
+	if err != nil {
+		return PixResponse{}, err
+	}
+
+	var pr PixResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
+
+func GetPix(c ClientCredentials, paymentID string) (PixResponse, error) {
+	return GetPixContext(context.Background(), c, paymentID)
+}
+
+func GetPixContext(ctx context.Context, c ClientCredentials, paymentID string) (PixResponse, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointPaymentPix + "/" + url.PathEscape(paymentID))
+	if err != nil {
+		return PixResponse{}, err
+	}
+
+	var pr PixResponse
+	err = json.Unmarshal(res.Body, &pr)
+	return pr, err
+}
+
+type PixResponse struct {
+	PaymentID      string            `json:"payment_id"`
+	Status         string            `json:"status"`
+	Description    string            `json:"description"`
+	AdditionalData PixAdditionalData `json:"additional_data"`
+}
+
+// EMV retorna o código "copia e cola", que também é o conteúdo do QR Code.
+func (p PixResponse) EMV() string {
+	return p.AdditionalData.QRCode
+}
+
+func (p PixResponse) ExpiresAt() time.Time {
+	return p.AdditionalData.ExpirationDate
+}
+
+func (p PixResponse) Waiting() bool {
+	return p.Status == PaymentWaiting
+}
+
+func (p PixResponse) Approved() bool {
+	return p.Status == PaymentApproved
+}
+
+func (p PixResponse) Denied() bool {
+	return p.Status == PaymentDenied
+}
+
+type PixAdditionalData struct {
+	TransactionID  string    `json:"transaction_id"`
+	QRCode         string    `json:"qr_code"`
+	CreationDate   time.Time `json:"creation_date_qrcode"`
+	ExpirationDate time.Time `json:"expiration_date_qrcode"`
+	PSPCode        string    `json:"psp_code"`
+}
+
+func (ad *PixAdditionalData) UnmarshalJSON(data []byte) error {
+	type Alias PixAdditionalData
+	aux := &struct {
+		*Alias
+		CreationDate   string `json:"creation_date_qrcode"`
+		ExpirationDate string `json:"expiration_date_qrcode"`
+	}{
+		Alias: (*Alias)(ad),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	ad.CreationDate, _ = time.Parse(time.RFC3339, aux.CreationDate)
+	ad.ExpirationDate, _ = time.Parse(time.RFC3339, aux.ExpirationDate)
+	return nil
+}
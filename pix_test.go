@@ -0,0 +1,93 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	pixQRCode = "00020101021226740014br.gov.bcb.pix2552pix.santander.com.br/qr/v2/cobv/9d36b84f-c70b-478f-b95c-12729b90ca255204000053039865406123.455802BR5925GETNET6009SAO PAULO62070503***6304C28E"
+)
+
+func TestPaymentPix(t *testing.T) {
+	server := serverTestPaymentPix()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := Pix{
+		Amount:   1.23,
+		Order:    Order{OrderID: "6d2e4380-d8a3-4ccb-9138-c289182818a3"},
+		Customer: Customer{CustomerID: "ea05ba48-d193-4eb8-a4e9-c9cae1e3e2aa"},
+	}
+	pr, err := p.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if pr.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, pr.PaymentID)
+	}
+	if !pr.Waiting() {
+		t.Errorf("Expected waiting payment, got '%s'", pr.Status)
+	}
+	if pr.EMV() != pixQRCode {
+		t.Errorf("Expected '%s', got '%s'", pixQRCode, pr.EMV())
+	}
+
+	expected := "2021-03-15 20:04:25 +0000 UTC"
+	if pr.ExpiresAt().String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, pr.ExpiresAt().String())
+	}
+
+	pr, err = GetPix(credentials, paymentID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", pr.Status)
+	}
+}
+
+func serverTestPaymentPix() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		status := PaymentApproved
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/payments/qrcode/pix":
+			var payload struct {
+				Amount     int    `json:"amount"`
+				OrderID    string `json:"order_id"`
+				CustomerID string `json:"customer_id"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			if payload.Amount != 123 || payload.OrderID == "" || payload.CustomerID == "" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			status = PaymentWaiting
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/payments/qrcode/pix/"+paymentID:
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprintf(rw, `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"status": "%s",
+"description": "QR Code gerado com sucesso e aguardando o pagamento.",
+"additional_data": {
+  "transaction_id": "1002217281190421",
+  "qr_code": "%s",
+  "creation_date_qrcode": "2021-03-15T19:54:25Z",
+  "expiration_date_qrcode": "2021-03-15T20:04:25Z",
+  "psp_code": "033"
+}
+}`, status, pixQRCode)
+	}))
+}
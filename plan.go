@@ -0,0 +1,179 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type PeriodType string
+type PlanStatus string
+
+const (
+	endpointPlans      = "/v1/plans"
+	endpointPlanStatus = "/v1/plans/%s/status/%s"
+
+	paymentTypeCreditCard = "credit_card"
+
+	// Periodicidade da cobrança do plano (period.type)
+	Yearly     PeriodType = "yearly"
+	Monthly    PeriodType = "monthly"
+	Bimonthly  PeriodType = "bimonthly"
+	Quarterly  PeriodType = "quarterly"
+	Semesterly PeriodType = "semesterly"
+	Specific   PeriodType = "specific"
+
+	// Status do plano (status)
+	PlanActive   PlanStatus = "active"
+	PlanInactive PlanStatus = "inactive"
+)
+
+type Plan struct {
+	PlanID       string      `json:"plan_id,omitempty"`
+	SellerID     string      `json:"seller_id,omitempty"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description,omitempty"`
+	Amount       float64     `json:"amount"`
+	Currency     Currency    `json:"currency"`
+	PaymentTypes []string    `json:"payment_types"`
+	SalesTax     int         `json:"sales_tax"`
+	ProductType  ProductType `json:"product_type"`
+	Period       Period      `json:"period"`
+	Status       PlanStatus  `json:"status,omitempty"`
+	CreateDate   time.Time   `json:"create_date"`
+}
+
+type Period struct {
+	Type                PeriodType `json:"type"`
+	BillingCycle        int        `json:"billing_cycle"`
+	SpecificCycleInDays int        `json:"specific_cycle_in_days,omitempty"`
+}
+
+func (p Plan) MarshalJSON() ([]byte, error) {
+	type Alias Plan
+	return json.Marshal(&struct {
+		Alias
+		Amount     int        `json:"amount"`
+		CreateDate *time.Time `json:"create_date,omitempty"`
+	}{
+		Alias:  (Alias)(p),
+		Amount: toCents(p.Amount),
+	})
+}
+
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type Alias Plan
+	aux := &struct {
+		*Alias
+		Amount     int    `json:"amount"`
+		CreateDate string `json:"create_date"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.Amount = fromCents(aux.Amount)
+	p.CreateDate, _ = time.Parse(time.RFC3339, aux.CreateDate)
+	return nil
+}
+
+func (p Plan) Active() bool {
+	return p.Status == PlanActive
+}
+
+func (p Plan) Create(c ClientCredentials) (Plan, error) {
+	return p.CreateContext(context.Background(), c)
+}
+
+func (p Plan) CreateContext(ctx context.Context, c ClientCredentials) (Plan, error) {
+	if p.SellerID == "" {
+		p.SellerID = c.SellerID
+	}
+	if p.Currency == "" {
+		p.Currency = RealBrazilian
+	}
+	if len(p.PaymentTypes) == 0 {
+		p.PaymentTypes = []string{paymentTypeCreditCard}
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointPlans, p)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var plan Plan
+	err = json.Unmarshal(res.Body, &plan)
+	return plan, err
+}
+
+func (p Plan) UpdateStatus(c ClientCredentials, status PlanStatus) (Plan, error) {
+	return p.UpdateStatusContext(context.Background(), c, status)
+}
+
+func (p Plan) UpdateStatusContext(ctx context.Context, c ClientCredentials, status PlanStatus) (Plan, error) {
+	endpoint := fmt.Sprintf(endpointPlanStatus, url.PathEscape(p.PlanID), status)
+	res, err := NewRestClient(c).WithContext(ctx).Patch(endpoint, struct{}{})
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var plan Plan
+	err = json.Unmarshal(res.Body, &plan)
+	return plan, err
+}
+
+func GetPlan(c ClientCredentials, planID string) (Plan, error) {
+	return GetPlanContext(context.Background(), c, planID)
+}
+
+func GetPlanContext(ctx context.Context, c ClientCredentials, planID string) (Plan, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointPlans + "/" + url.PathEscape(planID))
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var plan Plan
+	err = json.Unmarshal(res.Body, &plan)
+	return plan, err
+}
+
+func ListPlans(c ClientCredentials, f PlanFilter) (PlanList, error) {
+	return ListPlansContext(context.Background(), c, f)
+}
+
+func ListPlansContext(ctx context.Context, c ClientCredentials, f PlanFilter) (PlanList, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointPlans + "?" + f.query().Encode())
+	if err != nil {
+		return PlanList{}, err
+	}
+
+	var pl PlanList
+	err = json.Unmarshal(res.Body, &pl)
+	return pl, err
+}
+
+type PlanFilter struct {
+	Page     int
+	Limit    int
+	Name     string
+	PlanID   string
+	Status   PlanStatus
+	SortType string
+}
+
+func (f PlanFilter) query() url.Values {
+	query := url.Values{}
+	addPaging(query, f.Page, f.Limit)
+	addQuery(query, "name", f.Name)
+	addQuery(query, "plan_id", f.PlanID)
+	addQuery(query, "status", string(f.Status))
+	addQuery(query, "sort_type", f.SortType)
+	return query
+}
+
+type PlanList struct {
+	Paging
+	Plans []Plan `json:"plans"`
+}
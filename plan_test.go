@@ -0,0 +1,143 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	planID = "3b4c2f5a-2c7e-4a5b-9c1d-8e6f7a0b1c2d"
+)
+
+func TestPlanCreate(t *testing.T) {
+	server := serverTestPlans()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := Plan{
+		Name:        "Plano mensal",
+		Amount:      99.9,
+		ProductType: Service,
+		Period: Period{
+			Type:         Monthly,
+			BillingCycle: 12,
+		},
+	}
+	plan, err := p.Create(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if plan.PlanID != planID {
+		t.Errorf("Expected '%s', got '%s'", planID, plan.PlanID)
+	}
+	if plan.Amount != 99.9 {
+		t.Errorf("Expected '%f', got '%f'", 99.9, plan.Amount)
+	}
+	if plan.Currency != RealBrazilian {
+		t.Errorf("Expected '%s', got '%s'", RealBrazilian, plan.Currency)
+	}
+	if !plan.Active() {
+		t.Errorf("Expected active plan, got '%s'", plan.Status)
+	}
+
+	expected := "2017-12-27 16:28:45.116 +0000 UTC"
+	if plan.CreateDate.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, plan.CreateDate.String())
+	}
+}
+
+func TestGetAndListPlans(t *testing.T) {
+	server := serverTestPlans()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	plan, err := GetPlan(credentials, planID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if plan.Period.Type != Monthly {
+		t.Errorf("Expected '%s', got '%s'", Monthly, plan.Period.Type)
+	}
+
+	pl, err := ListPlans(credentials, PlanFilter{Status: PlanActive})
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(pl.Plans) != 1 || pl.Total != 1 {
+		t.Errorf("Expected 1 plan, got %d", len(pl.Plans))
+	}
+}
+
+func TestPlanUpdateStatus(t *testing.T) {
+	server := serverTestPlans()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	plan, err := Plan{PlanID: planID}.UpdateStatus(credentials, PlanInactive)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if plan.Status != PlanInactive {
+		t.Errorf("Expected '%s', got '%s'", PlanInactive, plan.Status)
+	}
+}
+
+func serverTestPlans() *httptest.Server {
+	plan := func(status PlanStatus) string {
+		return fmt.Sprintf(`{
+"plan_id": "%s",
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"name": "Plano mensal",
+"amount": 9990,
+"currency": "BRL",
+"payment_types": ["credit_card"],
+"sales_tax": 0,
+"product_type": "service",
+"period": {"type": "monthly", "billing_cycle": 12},
+"status": "%s",
+"create_date": "2017-12-27T16:28:45.116Z"
+}`, planID, status)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/plans":
+			var payload map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&payload)
+			if payload["amount"] != float64(9990) || payload["currency"] != "BRL" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if _, ok := payload["create_date"]; ok {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write([]byte(plan(PlanActive)))
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/plans":
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, `{"page": 1, "limit": 10, "total": 1, "plans": [%s]}`, plan(PlanStatus(req.URL.Query().Get("status"))))
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/plans/"+planID:
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(plan(PlanActive)))
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/plans/"+planID+"/status/inactive":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(plan(PlanInactive)))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -2,19 +2,52 @@ package getnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+const (
+	defaultTimeout         = 30 * time.Second
+	defaultMaxIdleConns    = 100
+	defaultIdleConnTimeout = 90 * time.Second
+)
+
+var defaultHTTPClient = NewHTTPClient(nil)
+
+// NewHTTPClient retorna um cliente HTTP com timeout padrão. Quando rt é nulo,
+// é utilizado um transporte com pool de conexões dimensionado para chamadas
+// concorrentes à API, com os timeouts aplicados na conexão, no handshake TLS e
+// na espera pela resposta, sem o custo de um timer por requisição.
+func NewHTTPClient(rt http.RoundTripper) *http.Client {
+	if rt == nil {
+		return &http.Client{Transport: newTransport()}
+	}
+	return &http.Client{
+		Timeout:   defaultTimeout,
+		Transport: rt,
+	}
+}
+
+func newTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = defaultMaxIdleConns
+	t.MaxIdleConnsPerHost = defaultMaxIdleConns
+	t.IdleConnTimeout = defaultIdleConnTimeout
+	t.ResponseHeaderTimeout = defaultTimeout
+	return t
+}
+
 type RestClient struct {
 	credentials ClientCredentials
 	authBasic   bool
+	ctx         context.Context
 }
 
 func NewRestClient(c ClientCredentials) RestClient {
@@ -27,6 +60,41 @@ func (r RestClient) AuthBasic() RestClient {
 	return r
 }
 
+func (r RestClient) WithContext(ctx context.Context) RestClient {
+	r.ctx = ctx
+	return r
+}
+
+func (r RestClient) context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+func (r RestClient) httpClient() *http.Client {
+	if r.credentials.HTTPClient != nil {
+		return r.credentials.HTTPClient
+	}
+	return defaultHTTPClient
+}
+
+// bearer retorna as credenciais com o token de acesso e, quando ele foi obtido
+// do TokenSource, a sua origem.
+func (r RestClient) bearer() (ClientCredentials, *TokenSource, error) {
+	c := r.credentials
+	if c.AccessToken.Token != "" {
+		return c, nil, nil
+	}
+	ts := c.tokenSource()
+	at, err := ts.TokenContext(r.context())
+	if err != nil {
+		return c, nil, err
+	}
+	c.AccessToken = at
+	return c, ts, nil
+}
+
 func (r RestClient) FormData(endpoint string, form url.Values) (Response, error) {
 	contentType := "application/x-www-form-urlencoded"
 	return r.send(http.MethodPost, endpoint, contentType, strings.NewReader(form.Encode()))
@@ -46,60 +114,71 @@ func (r RestClient) Post(endpoint string, value interface{}) (Response, error) {
 	return r.send(http.MethodPost, endpoint, contentType, bytes.NewReader(body))
 }
 
+func (r RestClient) Patch(endpoint string, value interface{}) (Response, error) {
+	contentType := "application/json; charset=utf-8"
+	body, err := json.Marshal(value)
+	if err != nil {
+		return Response{}, err
+	}
+	return r.send(http.MethodPatch, endpoint, contentType, bytes.NewReader(body))
+}
+
+func (r RestClient) Delete(endpoint string) (Response, error) {
+	contentType := "application/json; charset=utf-8"
+	return r.send(http.MethodDelete, endpoint, contentType, nil)
+}
+
 func (r RestClient) send(method, endpoint, contentType string, body io.Reader) (Response, error) {
 	url := r.credentials.URL() + endpoint
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(r.context(), method, url, body)
 	if err != nil {
 		return Response{}, err
 	}
 
+	var source *TokenSource
+	var at AccessToken
 	req.Header.Add("Content-type", contentType)
 	if r.authBasic {
 		req.Header.Add("Authorization", r.credentials.Basic())
 	} else {
-		req.Header.Add("Authorization", r.credentials.Bearer())
+		c, ts, err := r.bearer()
+		if err != nil {
+			return Response{}, err
+		}
+		source, at = ts, c.AccessToken
+		req.Header.Add("Authorization", c.Bearer())
 	}
 	if r.credentials.HasSeller() {
 		req.Header.Add("seller_id", r.credentials.SellerID)
 	}
 
-	httpClient := &http.Client{}
-	res, err := httpClient.Do(req)
+	res, err := r.httpClient().Do(req)
 	if err != nil {
 		return Response{}, err
 	}
 	defer res.Body.Close()
 
+	if source != nil && res.StatusCode == http.StatusUnauthorized {
+		source.invalidate(at)
+	}
+
 	content, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return Response{}, err
 	}
 
-	err = r.getError(res.StatusCode, content, endpoint)
+	err = r.getError(res.StatusCode, res.Header.Get("Content-Type"), content)
 	return Response{
 		Body: content,
 		Code: res.StatusCode,
 	}, err
 }
 
-func (r RestClient) getError(statusCode int, payload []byte, endpoint string) error {
+func (r RestClient) getError(statusCode int, contentType string, payload []byte) error {
 	if strings.HasPrefix(strconv.Itoa(statusCode), "2") {
 		return nil
 	}
-
-	if strings.Contains(endpoint, "/v1/") {
-		var errResponse ErrorResponseSchemaV1
-		if err := json.Unmarshal(payload, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(errResponse.String())
-	}
-
-	var errResponse ErrorResponseSchemaV2
-	if err := json.Unmarshal(payload, &errResponse); err != nil {
-		return err
-	}
-	return errors.New(errResponse.Description)
+	return decodeError(statusCode, contentType, payload)
 }
 
 type Response struct {
@@ -0,0 +1,150 @@
+package getnet
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextCanceled(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := Payment{}.PayContext(ctx, credentials)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected '%s', got '%v'", context.DeadlineExceeded, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	_, err = credentials.NewAccessTokenContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected '%s', got '%v'", context.Canceled, err)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCustomHTTPClient(t *testing.T) {
+	var requests int
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       ioutil.NopCloser(strings.NewReader(paymentCreditPayload)),
+			Header:     http.Header{},
+		}, nil
+	})
+
+	credentials := fixtureCredentials()
+	credentials.HTTPClient = NewHTTPClient(rt)
+
+	pr, err := Payment{}.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", pr.Status)
+	}
+	if requests != 1 {
+		t.Errorf("Expected '%d' request, got '%d'", 1, requests)
+	}
+	if credentials.HTTPClient.Timeout != defaultTimeout {
+		t.Errorf("Expected '%s', got '%s'", defaultTimeout, credentials.HTTPClient.Timeout)
+	}
+}
+
+func TestDefaultHTTPClient(t *testing.T) {
+	r := NewRestClient(fixtureCredentials())
+	if r.httpClient() != defaultHTTPClient {
+		t.Errorf("Expected the shared default HTTP client")
+	}
+	transport, ok := defaultHTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected '*http.Transport', got '%T'", defaultHTTPClient.Transport)
+	}
+	if transport.ResponseHeaderTimeout != defaultTimeout {
+		t.Errorf("Expected '%s', got '%s'", defaultTimeout, transport.ResponseHeaderTimeout)
+	}
+	if transport.TLSHandshakeTimeout == 0 {
+		t.Errorf("Expected a TLS handshake timeout")
+	}
+	if transport.MaxIdleConnsPerHost != defaultMaxIdleConns {
+		t.Errorf("Expected '%d', got '%d'", defaultMaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+}
+
+// BenchmarkPayNewConnection abre uma conexão nova a cada requisição, sem
+// reaproveitamento.
+func BenchmarkPayNewConnection(b *testing.B) {
+	benchmarkPay(b, func(c *ClientCredentials) {
+		c.HTTPClient = &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	})
+}
+
+// BenchmarkPayDefaultTransport reproduz o comportamento anterior: um
+// http.Client novo por requisição sobre o http.DefaultTransport, que mantém
+// apenas 2 conexões ociosas por host e, sob concorrência, fecha e reabre as
+// demais.
+func BenchmarkPayDefaultTransport(b *testing.B) {
+	benchmarkPay(b, func(c *ClientCredentials) {
+		c.HTTPClient = &http.Client{}
+	})
+}
+
+func BenchmarkPayPooledClient(b *testing.B) {
+	benchmarkPay(b, func(c *ClientCredentials) {})
+}
+
+func benchmarkPay(b *testing.B, setup func(*ClientCredentials)) {
+	// latência simulada da API, para manter várias requisições em andamento
+	// ao mesmo tempo, acima das 2 conexões ociosas por host do
+	// http.DefaultTransport. Com uma única CPU o cliente e o servidor disputam
+	// o processador e a diferença de latência some; compare com:
+	//   go test -run - -bench Pay -benchtime 3s -cpu 4
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(paymentCreditPayload))
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	b.ReportAllocs()
+	b.SetParallelism(128)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			credentials := fixtureCredentials()
+			setup(&credentials)
+			if _, err := (Payment{}).Pay(credentials); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package getnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// recoveryClockSkew tolera a diferença entre o relógio local e o received_at
+// informado pela Getnet.
+const recoveryClockSkew = 5 * time.Second
+
+var errOrderID = errors.New("Obrigatório informar o identificador do pedido (order_id) para recuperar o resultado do pagamento.")
+
+type SafePayResult struct {
+	PaymentResponse
+	// Recovered indica que o pagamento já havia sido processado pela Getnet e
+	// foi recuperado pela consulta por order_id, sem um novo envio.
+	Recovered bool
+}
+
+// SafePay envia o pagamento com cartão de crédito e, em falhas ambíguas
+// (timeout, conexão interrompida ou erro 5xx), consulta a Getnet pelo order_id
+// antes de reenviar, evitando cobrar o comprador duas vezes.
+func (p Payment) SafePay(c ClientCredentials) (SafePayResult, error) {
+	return p.SafePayContext(context.Background(), c)
+}
+
+func (p Payment) SafePayContext(ctx context.Context, c ClientCredentials) (SafePayResult, error) {
+	result, _, err := p.safePay(ctx, c)
+	return result, err
+}
+
+// safePay também indica se o resultado do pagamento é conhecido (resolved):
+// quando false, o pagamento pode ter sido processado pela Getnet mesmo com erro.
+// known são pagamentos do mesmo pedido que não correspondem a este envio.
+func (p Payment) safePay(ctx context.Context, c ClientCredentials, known ...string) (result SafePayResult, resolved bool, err error) {
+	if p.Order.OrderID == "" {
+		return SafePayResult{}, true, errOrderID
+	}
+
+	start := time.Now()
+	pr, res, err := p.pay(ctx, c)
+	if err == nil {
+		return SafePayResult{PaymentResponse: pr}, true, nil
+	}
+	if !ambiguous(res, err) {
+		return SafePayResult{}, true, err
+	}
+
+	payments, lerr := GetPaymentsByOrderIDContext(ctx, c, p.Order.OrderID)
+	if lerr != nil {
+		return SafePayResult{}, false, fmt.Errorf("Não foi possível recuperar o resultado do pagamento (%s): %w", lerr, err)
+	}
+	switch recovered := recoveredPayments(p, payments, start, known); len(recovered) {
+	case 0:
+	case 1:
+		return SafePayResult{PaymentResponse: recovered[0], Recovered: true}, true, nil
+	default:
+		return SafePayResult{}, false, fmt.Errorf("Mais de um pagamento do pedido %s corresponde ao envio, não foi possível recuperar o resultado: %w", p.Order.OrderID, err)
+	}
+
+	pr, res, err = p.pay(ctx, c)
+	if err != nil {
+		return SafePayResult{}, !ambiguous(res, err), err
+	}
+	return SafePayResult{PaymentResponse: pr}, true, nil
+}
+
+func ambiguous(res Response, err error) bool {
+	if res.Code >= http.StatusInternalServerError {
+		return true
+	}
+	if res.Code != 0 {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// recoveredPayments retorna os envios do pedido que correspondem ao pagamento:
+// mesmo valor e bandeira, recebidos pela Getnet a partir de since e ainda
+// válidos (aprovados, autorizados, confirmados ou pendentes). Tentativas
+// anteriores, negadas ou canceladas e os pagamentos em known são ignorados.
+func recoveredPayments(p Payment, payments []PaymentResponse, since time.Time, known []string) []PaymentResponse {
+	since = since.Add(-recoveryClockSkew)
+
+	var recovered []PaymentResponse
+	for _, pr := range payments {
+		if toCents(pr.Amount) != toCents(p.Amount) || pr.ReceivedAt.Before(since) {
+			continue
+		}
+		if !pr.Approved() && !pr.Authorized() && !pr.Confirmed() && !pr.Pending() {
+			continue
+		}
+		if p.Credit.Card.Brand != "" && pr.Credit.Brand != "" &&
+			!strings.EqualFold(string(p.Credit.Card.Brand), string(pr.Credit.Brand)) {
+			continue
+		}
+		if contains(known, pr.PaymentID) {
+			continue
+		}
+		recovered = append(recovered, pr)
+	}
+	return recovered
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package getnet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSafePayRecovered(t *testing.T) {
+	var posts int32
+	server := serverTestSafePay(&posts, true, func(rw http.ResponseWriter) {
+		rw.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	result, err := fixtureSafePayment().SafePay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !result.Recovered {
+		t.Errorf("Expected a recovered payment")
+	}
+	if !result.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", result.Status)
+	}
+	if posts != 1 {
+		t.Errorf("Expected '%d' payment submission, got '%d'", 1, posts)
+	}
+}
+
+func TestSafePayConnectionReset(t *testing.T) {
+	var posts int32
+	server := serverTestSafePay(&posts, true, func(rw http.ResponseWriter) {
+		conn, _, _ := rw.(http.Hijacker).Hijack()
+		conn.Close()
+	})
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	result, err := fixtureSafePayment().SafePay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !result.Recovered {
+		t.Errorf("Expected a recovered payment")
+	}
+}
+
+func TestSafePayResubmitted(t *testing.T) {
+	var posts int32
+	server := serverTestSafePay(&posts, false, func(rw http.ResponseWriter) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	result, err := fixtureSafePayment().SafePay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if result.Recovered {
+		t.Errorf("Expected a freshly created payment")
+	}
+	if result.PaymentID != paymentID {
+		t.Errorf("Expected '%s', got '%s'", paymentID, result.PaymentID)
+	}
+	if posts != 2 {
+		t.Errorf("Expected '%d' payment submissions, got '%d'", 2, posts)
+	}
+}
+
+func TestSafePayPreviousAttemptIgnored(t *testing.T) {
+	recent := receivedNow(paymentCreditPayload)
+	previous := map[string]string{
+		"denied":           strings.Replace(recent, `"APPROVED"`, `"DENIED"`, 1),
+		"canceled":         strings.Replace(recent, `"APPROVED"`, `"CANCELED"`, 1),
+		"different amount": strings.Replace(recent, `"amount": 123`, `"amount": 456`, 1),
+		"stale":            paymentCreditPayload,
+	}
+	for name, payload := range previous {
+		var posts int32
+		server := serverTestSafePayLookup(&posts, payload, func(rw http.ResponseWriter) {
+			rw.WriteHeader(http.StatusBadGateway)
+		})
+
+		urlStaging = server.URL
+
+		credentials := fixtureCredentials()
+
+		result, err := fixtureSafePayment().SafePay(credentials)
+		if err != nil {
+			t.Errorf("%s: There should not be an error, error: %s", name, err)
+		}
+		if result.Recovered {
+			t.Errorf("%s: Expected a freshly created payment", name)
+		}
+		if posts != 2 {
+			t.Errorf("%s: Expected '%d' payment submissions, got '%d'", name, 2, posts)
+		}
+		server.Close()
+	}
+}
+
+func TestSafePayAmbiguousLookup(t *testing.T) {
+	var posts int32
+	recent := receivedNow(paymentCreditPayload)
+	other := strings.Replace(recent, `"payment_id": "`, `"payment_id": "other-`, 1)
+	server := serverTestSafePayLookup(&posts, recent+","+other, func(rw http.ResponseWriter) {
+		rw.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	_, err := fixtureSafePayment().SafePay(credentials)
+	if err == nil || !strings.Contains(err.Error(), "Mais de um pagamento") {
+		t.Errorf("Expected ambiguous lookup error, got '%v'", err)
+	}
+	if posts != 1 {
+		t.Errorf("Expected '%d' payment submission, got '%d'", 1, posts)
+	}
+}
+
+func TestSafePayOrderIDRequired(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	_, err := Payment{}.SafePay(credentials)
+	if err != errOrderID {
+		t.Errorf("Expected '%s', got '%v'", errOrderID, err)
+	}
+}
+
+func fixtureSafePayment() Payment {
+	return Payment{
+		Amount: 1.23,
+		Order:  Order{OrderID: "6d2e4380-d8a3-4ccb-9138-c289182818a3"},
+	}
+}
+
+// serverTestSafePay falha o primeiro envio com fail; processed indica se a
+// Getnet chegou a processar esse envio antes da falha.
+func serverTestSafePay(posts *int32, processed bool, fail func(http.ResponseWriter)) *httptest.Server {
+	found := ""
+	if processed {
+		found = receivedNow(paymentCreditPayload)
+	}
+	return serverTestSafePayLookup(posts, found, fail)
+}
+
+// receivedNow troca o received_at do payload pelo horário atual, como o de um
+// pagamento recebido durante o SafePay.
+func receivedNow(payload string) string {
+	return strings.Replace(payload, "2017-03-19T16:30:30.764Z", time.Now().UTC().Format("2006-01-02T15:04:05.000Z"), 1)
+}
+
+// serverTestSafePayLookup retorna found na consulta por order_id.
+func serverTestSafePayLookup(posts *int32, found string, fail func(http.ResponseWriter)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		if req.Method == http.MethodGet {
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`{"payments": [` + found + `]}`))
+			return
+		}
+
+		if atomic.AddInt32(posts, 1) == 1 {
+			fail(rw)
+			return
+		}
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(paymentCreditPayload))
+	}))
+}
@@ -0,0 +1,243 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type SubscriptionStatus string
+
+const (
+	endpointSubscriptions           = "/v1/subscriptions"
+	endpointSubscriptionCancel      = "/v1/subscriptions/%s/cancel"
+	endpointSubscriptionPaymentDate = "/v1/subscriptions/%s/paymentDate"
+	endpointSubscriptionCard        = "/v1/subscriptions/%s/paymentType/credit/card"
+
+	// Status da assinatura (status)
+	SubscriptionSuccess  SubscriptionStatus = "success"
+	SubscriptionActive   SubscriptionStatus = "active"
+	SubscriptionCanceled SubscriptionStatus = "canceled"
+	SubscriptionFailed   SubscriptionStatus = "failed"
+)
+
+type Subscription struct {
+	SellerID           string          `json:"seller_id,omitempty"`
+	CustomerID         string          `json:"customer_id"`
+	PlanID             string          `json:"plan_id"`
+	OrderID            string          `json:"order_id"`
+	TransactionType    TransactionType `json:"-"`
+	NumberInstallments int             `json:"-"`
+	SoftDescriptor     string          `json:"-"`
+	BillingAddress     BillingAddress  `json:"-"`
+	Card               Card            `json:"-"`
+	Device             Device          `json:"device,omitempty"`
+
+	// TransactionID (CreditResponse) da transação original com o cartão,
+	// quando ele já foi utilizado anteriormente pelo comprador.
+	OriginalTransactionID string `json:"-"`
+}
+
+type subscriptionCredit struct {
+	TransactionType    TransactionType    `json:"transaction_type"`
+	NumberInstallments int                `json:"number_installments"`
+	SoftDescriptor     string             `json:"soft_descriptor,omitempty"`
+	BillingAddress     BillingAddress     `json:"billing_address"`
+	Card               Card               `json:"card"`
+	CredentialsOnFile  *CredentialsOnFile `json:"credentials_on_file,omitempty"`
+}
+
+func (s Subscription) MarshalJSON() ([]byte, error) {
+	type Alias Subscription
+	aux := &struct {
+		Alias
+		Subscription struct {
+			PaymentType struct {
+				Credit subscriptionCredit `json:"credit"`
+			} `json:"payment_type"`
+		} `json:"subscription"`
+	}{
+		Alias: (Alias)(s),
+	}
+	aux.Subscription.PaymentType.Credit = subscriptionCredit{
+		TransactionType:    s.TransactionType,
+		NumberInstallments: s.NumberInstallments,
+		SoftDescriptor:     maxLength(s.SoftDescriptor, 22),
+		BillingAddress:     s.BillingAddress,
+		Card:               s.Card,
+		CredentialsOnFile:  s.credentialsOnFile(),
+	}
+	return json.Marshal(aux)
+}
+
+// credentialsOnFile indica a cobrança recorrente iniciada pelo lojista quando
+// o cartão já foi utilizado (OriginalTransactionID); caso contrário, a primeira
+// cobrança da assinatura é iniciada pelo comprador.
+func (s Subscription) credentialsOnFile() *CredentialsOnFile {
+	if s.OriginalTransactionID == "" {
+		return &CredentialsOnFile{Type: Recurring, Initiator: InitiatorCardholder}
+	}
+	return &CredentialsOnFile{
+		Type:          Recurring,
+		Initiator:     InitiatorMerchant,
+		TransactionID: s.OriginalTransactionID,
+	}
+}
+
+func (s Subscription) Subscribe(c ClientCredentials) (SubscriptionResponse, error) {
+	return s.SubscribeContext(context.Background(), c)
+}
+
+func (s Subscription) SubscribeContext(ctx context.Context, c ClientCredentials) (SubscriptionResponse, error) {
+	if s.SellerID == "" {
+		s.SellerID = c.SellerID
+	}
+	if s.TransactionType == "" {
+		s.TransactionType = Full
+	}
+	if s.NumberInstallments < 1 {
+		s.NumberInstallments = 1
+	}
+	if err := s.credentialsOnFile().validate(); err != nil {
+		return SubscriptionResponse{}, err
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpointSubscriptions, s)
+	if err != nil {
+		return SubscriptionResponse{}, err
+	}
+
+	var sr SubscriptionResponse
+	err = json.Unmarshal(res.Body, &sr)
+	return sr, err
+}
+
+func GetSubscription(c ClientCredentials, subscriptionID string) (SubscriptionResponse, error) {
+	return GetSubscriptionContext(context.Background(), c, subscriptionID)
+}
+
+func GetSubscriptionContext(ctx context.Context, c ClientCredentials, subscriptionID string) (SubscriptionResponse, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Get(endpointSubscriptions + "/" + url.PathEscape(subscriptionID))
+	if err != nil {
+		return SubscriptionResponse{}, err
+	}
+
+	var sr SubscriptionResponse
+	err = json.Unmarshal(res.Body, &sr)
+	return sr, err
+}
+
+func (sr SubscriptionResponse) Cancel(c ClientCredentials, reason string) (SubscriptionResponse, error) {
+	return sr.CancelContext(context.Background(), c, reason)
+}
+
+func (sr SubscriptionResponse) CancelContext(ctx context.Context, c ClientCredentials, reason string) (SubscriptionResponse, error) {
+	payload := struct {
+		SellerID      string `json:"seller_id,omitempty"`
+		StatusDetails string `json:"status_details"`
+	}{
+		SellerID:      c.SellerID,
+		StatusDetails: reason,
+	}
+
+	endpoint := fmt.Sprintf(endpointSubscriptionCancel, url.PathEscape(sr.SubscriptionID))
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint, payload)
+	if err != nil {
+		return SubscriptionResponse{}, err
+	}
+
+	var canceled SubscriptionResponse
+	err = json.Unmarshal(res.Body, &canceled)
+	return canceled, err
+}
+
+func (sr SubscriptionResponse) ChangePaymentDate(c ClientCredentials, day int) (SubscriptionResponse, error) {
+	return sr.ChangePaymentDateContext(context.Background(), c, day)
+}
+
+func (sr SubscriptionResponse) ChangePaymentDateContext(ctx context.Context, c ClientCredentials, day int) (SubscriptionResponse, error) {
+	payload := struct {
+		Day int `json:"day"`
+	}{
+		Day: day,
+	}
+
+	endpoint := fmt.Sprintf(endpointSubscriptionPaymentDate, url.PathEscape(sr.SubscriptionID))
+	res, err := NewRestClient(c).WithContext(ctx).Patch(endpoint, payload)
+	if err != nil {
+		return SubscriptionResponse{}, err
+	}
+
+	var changed SubscriptionResponse
+	err = json.Unmarshal(res.Body, &changed)
+	return changed, err
+}
+
+func (sr SubscriptionResponse) ChangeCard(c ClientCredentials, card Card) (SubscriptionResponse, error) {
+	return sr.ChangeCardContext(context.Background(), c, card)
+}
+
+func (sr SubscriptionResponse) ChangeCardContext(ctx context.Context, c ClientCredentials, card Card) (SubscriptionResponse, error) {
+	if card.NumberToken == "" {
+		return SubscriptionResponse{}, errNumberToken
+	}
+
+	endpoint := fmt.Sprintf(endpointSubscriptionCard, url.PathEscape(sr.SubscriptionID))
+	res, err := NewRestClient(c).WithContext(ctx).Patch(endpoint, card)
+	if err != nil {
+		return SubscriptionResponse{}, err
+	}
+
+	var changed SubscriptionResponse
+	err = json.Unmarshal(res.Body, &changed)
+	return changed, err
+}
+
+type SubscriptionResponse struct {
+	SubscriptionID    string             `json:"-"`
+	SellerID          string             `json:"seller_id"`
+	OrderID           string             `json:"order_id"`
+	Status            SubscriptionStatus `json:"status"`
+	StatusDetails     string             `json:"status_details"`
+	CreateDate        time.Time          `json:"create_date"`
+	PaymentDate       int                `json:"payment_date"`
+	NextScheduledDate time.Time          `json:"next_scheduled_date"`
+	EndDate           time.Time          `json:"end_date"`
+	Customer          Customer           `json:"-"`
+	Plan              Plan               `json:"plan"`
+	Payment           PaymentResponse    `json:"payment"`
+}
+
+func (sr SubscriptionResponse) Active() bool {
+	return sr.Status == SubscriptionActive || sr.Status == SubscriptionSuccess
+}
+
+func (sr SubscriptionResponse) Canceled() bool {
+	return sr.Status == SubscriptionCanceled
+}
+
+func (sr *SubscriptionResponse) UnmarshalJSON(data []byte) error {
+	type Alias SubscriptionResponse
+	aux := &struct {
+		*Alias
+		Subscription struct {
+			SubscriptionID string `json:"subscription_id"`
+		} `json:"subscription"`
+		Customer          registeredCustomer `json:"customer"`
+		CreateDate        string             `json:"create_date"`
+		NextScheduledDate string             `json:"next_scheduled_date"`
+		EndDate           string             `json:"end_date"`
+	}{
+		Alias: (*Alias)(sr),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	sr.SubscriptionID = aux.Subscription.SubscriptionID
+	sr.Customer = aux.Customer.customer()
+	sr.CreateDate = parseDate(aux.CreateDate)
+	sr.NextScheduledDate = parseDate(aux.NextScheduledDate)
+	sr.EndDate = parseDate(aux.EndDate)
+	return nil
+}
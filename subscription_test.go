@@ -0,0 +1,161 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	subscriptionID = "7b9d6a8e-6e3f-4d8b-8f2b-0b9a5c1d2e3f"
+)
+
+func TestSubscribe(t *testing.T) {
+	server := serverTestSubscriptions()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	s := Subscription{
+		CustomerID: customerID,
+		PlanID:     planID,
+		OrderID:    "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+		Card:       Card{NumberToken: numberToken, Brand: Mastercard},
+	}
+	sr, err := s.Subscribe(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if sr.SubscriptionID != subscriptionID {
+		t.Errorf("Expected '%s', got '%s'", subscriptionID, sr.SubscriptionID)
+	}
+	if !sr.Active() {
+		t.Errorf("Expected active subscription, got '%s'", sr.Status)
+	}
+	if sr.Customer.CustomerID != customerID {
+		t.Errorf("Expected '%s', got '%s'", customerID, sr.Customer.CustomerID)
+	}
+	if sr.Plan.Amount != 99.9 {
+		t.Errorf("Expected '%f', got '%f'", 99.9, sr.Plan.Amount)
+	}
+	if !sr.Payment.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", sr.Payment.Status)
+	}
+
+	expected := "2018-01-27 00:00:00 +0000 UTC"
+	if sr.NextScheduledDate.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, sr.NextScheduledDate.String())
+	}
+}
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	server := serverTestSubscriptions()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	sr, err := GetSubscription(credentials, subscriptionID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	sr, err = sr.ChangePaymentDate(credentials, 10)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if sr.PaymentDate != 10 {
+		t.Errorf("Expected '%d', got '%d'", 10, sr.PaymentDate)
+	}
+
+	_, err = sr.ChangeCard(credentials, Card{})
+	if err != errNumberToken {
+		t.Errorf("Expected '%s', got '%v'", errNumberToken, err)
+	}
+	_, err = sr.ChangeCard(credentials, Card{NumberToken: numberToken})
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	sr, err = sr.Cancel(credentials, "Cancelado a pedido do cliente")
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !sr.Canceled() {
+		t.Errorf("Expected canceled subscription, got '%s'", sr.Status)
+	}
+	if sr.StatusDetails != "Cancelado a pedido do cliente" {
+		t.Errorf("Expected '%s', got '%s'", "Cancelado a pedido do cliente", sr.StatusDetails)
+	}
+}
+
+func serverTestSubscriptions() *httptest.Server {
+	subscription := func(status SubscriptionStatus, details string, day int) string {
+		return fmt.Sprintf(`{
+"seller_id": "6eb2412c-165a-41cd-b1d9-76c575d70a28",
+"order_id": "6d2e4380-d8a3-4ccb-9138-c289182818a3",
+"create_date": "2017-12-27T16:28:45.116Z",
+"payment_date": %d,
+"next_scheduled_date": "2018-01-27",
+"subscription": {"subscription_id": "%s"},
+"customer": {"customer_id": "%s", "first_name": "João"},
+"plan": {"plan_id": "%s", "amount": 9990, "currency": "BRL", "period": {"type": "monthly", "billing_cycle": 12}},
+"status": "%s",
+"status_details": "%s",
+"payment": {"payment_id": "%s", "amount": 9990, "status": "APPROVED"}
+}`, day, subscriptionID, customerID, planID, status, details, paymentID)
+	}
+
+	base := "/v1/subscriptions/" + subscriptionID
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/subscriptions":
+			var payload struct {
+				Subscription struct {
+					PaymentType struct {
+						Credit struct {
+							TransactionType TransactionType `json:"transaction_type"`
+							Card            Card            `json:"card"`
+						} `json:"credit"`
+					} `json:"payment_type"`
+				} `json:"subscription"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			credit := payload.Subscription.PaymentType.Credit
+			if credit.Card.NumberToken != numberToken || credit.TransactionType != Full {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusCreated)
+			rw.Write([]byte(subscription(SubscriptionSuccess, "", 27)))
+		case req.Method == http.MethodGet && req.URL.Path == base:
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(subscription(SubscriptionActive, "", 27)))
+		case req.Method == http.MethodPatch && req.URL.Path == base+"/paymentDate":
+			var payload struct {
+				Day int `json:"day"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(subscription(SubscriptionActive, "", payload.Day)))
+		case req.Method == http.MethodPatch && req.URL.Path == base+"/paymentType/credit/card":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(subscription(SubscriptionActive, "", 10)))
+		case req.Method == http.MethodPost && req.URL.Path == base+"/cancel":
+			var payload struct {
+				StatusDetails string `json:"status_details"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(subscription(SubscriptionCanceled, payload.StatusDetails, 10)))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
@@ -0,0 +1,186 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+var errNotAuthenticated = errors.New("Autenticação 3DS não concluída. Verifique o status retornado pelo emissor.")
+
+type ThreeDSStatus string
+
+const (
+	endpoint3DSTokens               = "/v1/3ds/tokens"
+	endpoint3DSDeviceDataCollection = "/v1/3ds/device-data-collection"
+	endpoint3DSAuthentications      = "/v1/3ds/authentications"
+	endpoint3DSResults              = "/v1/3ds/authentications/results"
+
+	// Status da autenticação 3DS (status)
+	ThreeDSAuthenticated     ThreeDSStatus = "AUTHENTICATED"
+	ThreeDSChallengeRequired ThreeDSStatus = "CHALLENGE_REQUIRED"
+	ThreeDSNotEnrolled       ThreeDSStatus = "NOT_ENROLLED"
+	ThreeDSFailed            ThreeDSStatus = "FAILED"
+)
+
+type ThreeDSToken struct {
+	Token     string `json:"token"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+func NewThreeDSToken(c ClientCredentials) (ThreeDSToken, error) {
+	return NewThreeDSTokenContext(context.Background(), c)
+}
+
+func NewThreeDSTokenContext(ctx context.Context, c ClientCredentials) (ThreeDSToken, error) {
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint3DSTokens, struct{}{})
+	if err != nil {
+		return ThreeDSToken{}, err
+	}
+
+	var t ThreeDSToken
+	err = json.Unmarshal(res.Body, &t)
+	return t, err
+}
+
+func (t ThreeDSToken) DeviceDataCollection(c ClientCredentials, card Card) (DeviceDataCollection, error) {
+	return t.DeviceDataCollectionContext(context.Background(), c, card)
+}
+
+func (t ThreeDSToken) DeviceDataCollectionContext(ctx context.Context, c ClientCredentials, card Card) (DeviceDataCollection, error) {
+	if card.NumberToken == "" {
+		return DeviceDataCollection{}, errNumberToken
+	}
+
+	payload := struct {
+		Token string `json:"token"`
+		Card  Card   `json:"card"`
+	}{
+		Token: t.Token,
+		Card:  card,
+	}
+
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint3DSDeviceDataCollection, payload)
+	if err != nil {
+		return DeviceDataCollection{}, err
+	}
+
+	var ddc DeviceDataCollection
+	err = json.Unmarshal(res.Body, &ddc)
+	return ddc, err
+}
+
+type DeviceDataCollection struct {
+	ReferenceID string `json:"reference_id"`
+	URL         string `json:"device_data_collection_url"`
+	AccessToken string `json:"access_token"`
+}
+
+type Enrollment struct {
+	Token       string   `json:"token"`
+	ReferenceID string   `json:"reference_id"`
+	Amount      float64  `json:"amount"`
+	Currency    Currency `json:"currency"`
+	OrderID     string   `json:"order_id"`
+	Card        Card     `json:"card"`
+	Customer    Customer `json:"customer"`
+	Device      Device   `json:"device,omitempty"`
+	ReturnURL   string   `json:"return_url,omitempty"`
+}
+
+func (e Enrollment) MarshalJSON() ([]byte, error) {
+	type Alias Enrollment
+	return json.Marshal(&struct {
+		Alias
+		Amount int `json:"amount"`
+	}{
+		Alias:  (Alias)(e),
+		Amount: toCents(e.Amount),
+	})
+}
+
+func (e Enrollment) Lookup(c ClientCredentials) (ThreeDSResult, error) {
+	return e.LookupContext(context.Background(), c)
+}
+
+func (e Enrollment) LookupContext(ctx context.Context, c ClientCredentials) (ThreeDSResult, error) {
+	if e.Currency == "" {
+		e.Currency = RealBrazilian
+	}
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint3DSAuthentications, e)
+	if err != nil {
+		return ThreeDSResult{}, err
+	}
+
+	var r ThreeDSResult
+	err = json.Unmarshal(res.Body, &r)
+	return r, err
+}
+
+type ThreeDSResult struct {
+	Status                      ThreeDSStatus  `json:"status"`
+	AuthenticationTransactionID string         `json:"authentication_transaction_id"`
+	ACSURL                      string         `json:"acs_url"`
+	Payload                     string         `json:"payload"`
+	Authentication              Authentication `json:"authentication"`
+}
+
+func (r ThreeDSResult) Authenticated() bool {
+	return r.Status == ThreeDSAuthenticated
+}
+
+func (r ThreeDSResult) ChallengeRequired() bool {
+	return r.Status == ThreeDSChallengeRequired
+}
+
+// Validate conclui o desafio do emissor, a partir do conteúdo (CRes) devolvido
+// pelo ACS ao final do challenge.
+func (r ThreeDSResult) Validate(c ClientCredentials, challengeResponse string) (ThreeDSResult, error) {
+	return r.ValidateContext(context.Background(), c, challengeResponse)
+}
+
+func (r ThreeDSResult) ValidateContext(ctx context.Context, c ClientCredentials, challengeResponse string) (ThreeDSResult, error) {
+	payload := struct {
+		AuthenticationTransactionID string `json:"authentication_transaction_id"`
+		Payload                     string `json:"payload"`
+	}{
+		AuthenticationTransactionID: r.AuthenticationTransactionID,
+		Payload:                     challengeResponse,
+	}
+
+	res, err := NewRestClient(c).WithContext(ctx).Post(endpoint3DSResults, payload)
+	if err != nil {
+		return ThreeDSResult{}, err
+	}
+
+	var validated ThreeDSResult
+	err = json.Unmarshal(res.Body, &validated)
+	return validated, err
+}
+
+type Authentication struct {
+	ECI string `json:"eci"`
+	// CAVV (Visa) ou UCAF (Mastercard)
+	CAVV            string `json:"cavv"`
+	XID             string `json:"xid,omitempty"`
+	DSTransactionID string `json:"ds_transaction_id"`
+	Version         string `json:"version,omitempty"`
+}
+
+func (c Credit) WithAuthentication(a Authentication) Credit {
+	c.Authenticated = true
+	c.Authentication = &a
+	return c
+}
+
+func (p Payment) PayAuthenticated(c ClientCredentials, r ThreeDSResult) (PaymentResponse, error) {
+	return p.PayAuthenticatedContext(context.Background(), c, r)
+}
+
+func (p Payment) PayAuthenticatedContext(ctx context.Context, c ClientCredentials, r ThreeDSResult) (PaymentResponse, error) {
+	if !r.Authenticated() {
+		return PaymentResponse{}, errNotAuthenticated
+	}
+	p.Credit = p.Credit.WithAuthentication(r.Authentication)
+	return p.PayContext(ctx, c)
+}
@@ -0,0 +1,133 @@
+package getnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	authenticationTransactionID = "q7z3AbYd4ZAbcdW8c4C1"
+	challengeResponse           = "eyJ0aHJlZURTU2VydmVyVHJhbnNJRCI6IjNhYzdjYWE3LWFhNDItMjY2My03OTFiLTJhYzA1YTU0MmM0YSJ9"
+	cavv                        = "AAABBEg0VhI0VniQEjRWAAAAAAA="
+)
+
+func TestThreeDSFlow(t *testing.T) {
+	server := serverTestThreeDS()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+	card := Card{NumberToken: numberToken, Brand: Visa}
+
+	token, err := NewThreeDSToken(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	ddc, err := token.DeviceDataCollection(credentials, card)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+
+	result, err := Enrollment{
+		Token:       token.Token,
+		ReferenceID: ddc.ReferenceID,
+		Amount:      1.23,
+		Card:        card,
+	}.Lookup(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !result.ChallengeRequired() {
+		t.Errorf("Expected challenge required, got '%s'", result.Status)
+	}
+
+	result, err = result.Validate(credentials, challengeResponse)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !result.Authenticated() {
+		t.Errorf("Expected authenticated, got '%s'", result.Status)
+	}
+
+	p := Payment{Amount: 1.23, Credit: Credit{Card: card}}
+	pr, err := p.PayAuthenticated(credentials, result)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if !pr.Approved() {
+		t.Errorf("Expected approved payment, got '%s'", pr.Status)
+	}
+}
+
+func TestPayAuthenticatedNotAuthenticated(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	p := Payment{Amount: 1.23}
+	_, err := p.PayAuthenticated(credentials, ThreeDSResult{Status: ThreeDSFailed})
+	if err != errNotAuthenticated {
+		t.Errorf("Expected '%s', got '%v'", errNotAuthenticated, err)
+	}
+}
+
+func serverTestThreeDS() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		var response interface{}
+		switch req.URL.Path {
+		case "/v1/3ds/tokens":
+			response = ThreeDSToken{Token: "3ds-token", ExpiresIn: 600}
+		case "/v1/3ds/device-data-collection":
+			response = DeviceDataCollection{
+				ReferenceID: "b8f3c2e1-reference",
+				URL:         "https://centinelapistag.cardinalcommerce.com/V1/Cruise/Collect",
+			}
+		case "/v1/3ds/authentications":
+			var e struct {
+				Amount      int    `json:"amount"`
+				ReferenceID string `json:"reference_id"`
+			}
+			json.NewDecoder(req.Body).Decode(&e)
+			if e.Amount != 123 || e.ReferenceID == "" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			response = ThreeDSResult{
+				Status:                      ThreeDSChallengeRequired,
+				AuthenticationTransactionID: authenticationTransactionID,
+				ACSURL:                      "https://acs.emissor.com.br/challenge",
+				Payload:                     "creq",
+			}
+		case "/v1/3ds/authentications/results":
+			response = ThreeDSResult{
+				Status:                      ThreeDSAuthenticated,
+				AuthenticationTransactionID: authenticationTransactionID,
+				Authentication: Authentication{
+					ECI:             "05",
+					CAVV:            cavv,
+					DSTransactionID: "f25084f0-5b16-4c0a-ae5d-b24808a95e4b",
+					Version:         "2.1.0",
+				},
+			}
+		case "/v1/payments/credit":
+			var p struct {
+				Credit Credit `json:"credit"`
+			}
+			json.NewDecoder(req.Body).Decode(&p)
+			if !p.Credit.Authenticated || p.Credit.Authentication == nil || p.Credit.Authentication.CAVV != cavv {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			response = map[string]string{"payment_id": paymentID, "status": PaymentApproved}
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		json.NewEncoder(rw).Encode(response)
+	}))
+}
@@ -0,0 +1,150 @@
+package getnet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	tokenRetries = 3
+)
+
+var (
+	tokenRetryBackoff = 200 * time.Millisecond
+
+	tokenSources sync.Map
+)
+
+// TokenSource mantém o token de acesso em cache, renovando-o antes do
+// vencimento com uma única chamada ao endpoint de autenticação, mesmo sob
+// demanda concorrente.
+type TokenSource struct {
+	credentials ClientCredentials
+
+	mu         sync.Mutex
+	token      AccessToken
+	err        error
+	refreshing chan struct{}
+}
+
+// NewTokenSource cria um TokenSource para as credenciais c. As renovações do
+// token usam o HTTPClient de c.
+func NewTokenSource(c ClientCredentials) *TokenSource {
+	c.AccessToken = AccessToken{}
+	c.TokenSource = nil
+	return &TokenSource{credentials: c}
+}
+
+func (ts *TokenSource) Token() (AccessToken, error) {
+	return ts.TokenContext(context.Background())
+}
+
+func (ts *TokenSource) TokenContext(ctx context.Context) (AccessToken, error) {
+	ts.mu.Lock()
+	if ts.token.Token != "" && !ts.token.Expired() {
+		t := ts.token
+		if ts.token.stale() {
+			ts.refresh()
+		}
+		ts.mu.Unlock()
+		return t, nil
+	}
+	done := ts.refresh()
+	ts.mu.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return AccessToken{}, ctx.Err()
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.token, ts.err
+}
+
+// refresh inicia a renovação do token, caso nenhuma esteja em andamento, e
+// retorna um canal fechado ao seu término. Deve ser chamado com ts.mu travado.
+func (ts *TokenSource) refresh() chan struct{} {
+	if ts.refreshing != nil {
+		return ts.refreshing
+	}
+	done := make(chan struct{})
+	ts.refreshing = done
+
+	go func() {
+		t, err := ts.fetch()
+
+		ts.mu.Lock()
+		if err == nil {
+			ts.token = t
+		}
+		ts.err = err
+		ts.refreshing = nil
+		ts.mu.Unlock()
+		close(done)
+	}()
+	return done
+}
+
+func (ts *TokenSource) fetch() (AccessToken, error) {
+	var t AccessToken
+	var err error
+	for i := 0; i < tokenRetries; i++ {
+		if i > 0 {
+			time.Sleep(tokenRetryBackoff << uint(i-1))
+		}
+		t, err = ts.credentials.NewAccessToken()
+		if err == nil || !retryable(err) {
+			return t, err
+		}
+	}
+	return t, err
+}
+
+// invalidate descarta o token em cache, caso ainda seja at, após a API
+// recusá-lo; a próxima chamada obtém um novo token.
+func (ts *TokenSource) invalidate(at AccessToken) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.token.Token == at.Token {
+		ts.token = AccessToken{}
+	}
+}
+
+// retryable indica falhas transitórias na obtenção do token: erros de rede e
+// respostas 5xx. Credenciais inválidas (IsUnauthorized) não são repetidas.
+func retryable(err error) bool {
+	if IsUnauthorized(err) {
+		return false
+	}
+	var e *APIError
+	if errors.As(err, &e) {
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// stale indica que o token já consumiu 80% da sua validade e deve ser renovado.
+func (at AccessToken) stale() bool {
+	return time.Since(at.createdAt) > time.Duration(at.ExpiresIn)*time.Second*4/5
+}
+
+func (cc ClientCredentials) tokenSource() *TokenSource {
+	if cc.TokenSource != nil {
+		return cc.TokenSource
+	}
+	key := [4]string{cc.URL(), cc.ClientID, cc.ClientSecret, cc.SellerID}
+	if ts, ok := tokenSources.Load(key); ok {
+		return ts.(*TokenSource)
+	}
+	// o TokenSource compartilhado usa sempre o cliente padrão, e não o
+	// HTTPClient de quem o criou
+	shared := NewTokenSource(cc)
+	shared.credentials.HTTPClient = nil
+	ts, _ := tokenSources.LoadOrStore(key, shared)
+	return ts.(*TokenSource)
+}
@@ -0,0 +1,290 @@
+package getnet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenSourceConcurrent(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 0)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	ts := NewTokenSource(fixtureCredentials())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			at, err := ts.Token()
+			if err != nil {
+				t.Errorf("There should not be an error, error: %s", err)
+			}
+			if at.Token != token {
+				t.Errorf("Expected '%s', got '%s'", token, at.Token)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+func TestTokenSourceRetry(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 2)
+	defer server.Close()
+
+	urlStaging = server.URL
+	defer func(backoff time.Duration) { tokenRetryBackoff = backoff }(tokenRetryBackoff)
+	tokenRetryBackoff = time.Millisecond
+
+	at, err := NewTokenSource(fixtureCredentials()).Token()
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if at.Token != token {
+		t.Errorf("Expected '%s', got '%s'", token, at.Token)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("Expected '%d', got '%d'", 3, n)
+	}
+}
+
+func TestTokenSourceRetryExhausted(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, tokenRetries)
+	defer server.Close()
+
+	urlStaging = server.URL
+	defer func(backoff time.Duration) { tokenRetryBackoff = backoff }(tokenRetryBackoff)
+	tokenRetryBackoff = time.Millisecond
+
+	_, err := NewTokenSource(fixtureCredentials()).Token()
+	if err == nil {
+		t.Errorf("Expected an error")
+	}
+	if n := atomic.LoadInt32(&calls); n != tokenRetries {
+		t.Errorf("Expected '%d', got '%d'", tokenRetries, n)
+	}
+}
+
+func TestTokenSourceUnauthorizedNotRetried(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		rw.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(rw).Encode(ErrorResponseSchemaV2{Error: "invalid_client", Description: "Não autorizado."})
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	_, err := NewTokenSource(fixtureCredentials()).Token()
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error, got '%v'", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+func TestTokenSourceRefreshAhead(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 0)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	ts := NewTokenSource(fixtureCredentials())
+	ts.token = AccessToken{
+		Token:     "stale-token",
+		ExpiresIn: expiresIn,
+		createdAt: time.Now().Add(-expiresIn * time.Second * 9 / 10),
+	}
+
+	at, err := ts.Token()
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if at.Token != "stale-token" {
+		t.Errorf("Expected '%s', got '%s'", "stale-token", at.Token)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for at.Token != token && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		at, _ = ts.Token()
+	}
+	if at.Token != token {
+		t.Errorf("Expected '%s', got '%s'", token, at.Token)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+func TestRestClientTokenSource(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 0)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	c := fixtureCredentials()
+	c.AccessToken = AccessToken{}
+	for i := 0; i < 3; i++ {
+		_, err := GetPix(c, paymentID)
+		if err != nil {
+			t.Errorf("There should not be an error, error: %s", err)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+func TestRestClientTokenSourceInvalidated(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == authTokenURL {
+			n := atomic.AddInt32(&calls, 1)
+			rw.WriteHeader(http.StatusOK)
+			json.NewEncoder(rw).Encode(AccessToken{
+				Token:     fmt.Sprintf("token-%d", n),
+				ExpiresIn: expiresIn,
+			})
+			return
+		}
+
+		// o primeiro token foi revogado
+		if req.Header.Get("Authorization") != "Bearer token-2" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(rw).Encode(ErrorResponseSchemaV1{Message: "Invalid Authorization"})
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`{"payment_id":"` + paymentID + `"}`))
+	}))
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	c := fixtureCredentials()
+	c.AccessToken = AccessToken{}
+	_, err := GetPix(c, paymentID)
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error, got '%v'", err)
+	}
+	_, err = GetPix(c, paymentID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Expected '%d', got '%d'", 2, n)
+	}
+}
+
+func TestRestClientTokenSourceHTTPClient(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 0)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	// o cliente do chamador não é usado na renovação do TokenSource compartilhado
+	var requests int32
+	c := fixtureCredentials()
+	c.AccessToken = AccessToken{}
+	c.HTTPClient = NewHTTPClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		if req.URL.Path == authTokenURL {
+			return nil, errors.New("stub transport")
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+	if _, err := GetPix(c, paymentID); err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+func TestTokenSourceOwnHTTPClient(t *testing.T) {
+	var calls int32
+	server := serverTestTokenSource(&calls, 0)
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	var refreshes int32
+	own := fixtureCredentials()
+	own.HTTPClient = NewHTTPClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&refreshes, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+
+	c := fixtureCredentials()
+	c.AccessToken = AccessToken{}
+	c.TokenSource = NewTokenSource(own)
+	c.HTTPClient = NewHTTPClient(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == authTokenURL {
+			return nil, errors.New("stub transport")
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+	if _, err := GetPix(c, paymentID); err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Errorf("Expected '%d', got '%d'", 1, n)
+	}
+}
+
+// serverTestTokenSource responde ao endpoint de autenticação, falhando as
+// primeiras failures chamadas, e exige o token emitido nas demais rotas.
+func serverTestTokenSource(calls *int32, failures int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == authTokenURL {
+			n := atomic.AddInt32(calls, 1)
+			time.Sleep(20 * time.Millisecond)
+			if n <= failures {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				json.NewEncoder(rw).Encode(ErrorResponseSchemaV2{Description: "Indisponível"})
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			json.NewEncoder(rw).Encode(AccessToken{
+				Token:     token,
+				TokenType: tokenType,
+				ExpiresIn: expiresIn,
+				Scope:     scope,
+			})
+			return
+		}
+
+		if req.Header.Get("Authorization") != "Bearer "+token {
+			rw.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(rw).Encode(ErrorResponseSchemaV2{Description: "Não autorizado."})
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`{"payment_id":"` + paymentID + `"}`))
+	}))
+}
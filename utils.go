@@ -1,8 +1,57 @@
 package getnet
 
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+)
+
 func maxLength(s string, l int) string {
 	if l > len(s) {
 		l = len(s)
 	}
 	return s[0:l]
 }
+
+func toCents(amount float64) int {
+	return int(math.Round(amount * 100))
+}
+
+func fromCents(amount int) float64 {
+	return float64(amount) / 100
+}
+
+func parseDate(value string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+type Paging struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+	Total int `json:"total"`
+}
+
+func (p Paging) HasNext() bool {
+	return p.Page*p.Limit < p.Total
+}
+
+func addPaging(query url.Values, page, limit int) {
+	if page > 0 {
+		query.Add("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Add("limit", strconv.Itoa(limit))
+	}
+}
+
+func addQuery(query url.Values, key, value string) {
+	if value != "" {
+		query.Add(key, value)
+	}
+}
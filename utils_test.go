@@ -18,3 +18,45 @@ func TestSubstring(t *testing.T) {
 		t.Errorf("Expected '%s', got '%s'", expected, got)
 	}
 }
+
+func TestCents(t *testing.T) {
+	got := toCents(12.34)
+	if got != 1234 {
+		t.Errorf("Expected '%d', got '%d'", 1234, got)
+	}
+
+	amount := fromCents(1234)
+	if amount != 12.34 {
+		t.Errorf("Expected '%f', got '%f'", 12.34, amount)
+	}
+}
+
+func TestPagingHasNext(t *testing.T) {
+	p := Paging{Page: 1, Limit: 10, Total: 11}
+	if !p.HasNext() {
+		t.Errorf("Expected next page")
+	}
+
+	p.Page = 2
+	if p.HasNext() {
+		t.Errorf("Expected no next page")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	expected := "2017-12-27 16:28:45.116 +0000 UTC"
+	got := parseDate("2017-12-27T16:28:45.116Z")
+	if got.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, got.String())
+	}
+
+	expected = "2018-01-27 00:00:00 +0000 UTC"
+	got = parseDate("2018-01-27")
+	if got.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, got.String())
+	}
+
+	if !parseDate("").IsZero() {
+		t.Errorf("Expected zero time")
+	}
+}
@@ -0,0 +1,150 @@
+package getnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+)
+
+var errCustomerID = errors.New("Obrigatório informar o identificador do comprador (customer_id).")
+
+const (
+	endpointCards = "/v1/cards"
+)
+
+func (c Card) Save(cc ClientCredentials) (SavedCard, error) {
+	return c.SaveContext(context.Background(), cc)
+}
+
+func (c Card) SaveContext(ctx context.Context, cc ClientCredentials) (SavedCard, error) {
+	if c.NumberToken == "" {
+		return SavedCard{}, errNumberToken
+	}
+	if c.CustomerID == "" {
+		return SavedCard{}, errCustomerID
+	}
+
+	payload := struct {
+		Card
+		CustomerID string `json:"customer_id"`
+		VerifyCard bool   `json:"verify_card"`
+	}{
+		Card:       c,
+		CustomerID: c.CustomerID,
+	}
+
+	res, err := NewRestClient(cc).WithContext(ctx).Post(endpointCards, payload)
+	if err != nil {
+		return SavedCard{}, err
+	}
+
+	var sc SavedCard
+	err = json.Unmarshal(res.Body, &sc)
+	return sc, err
+}
+
+func ListCards(cc ClientCredentials, customerID string) ([]VaultCard, error) {
+	return ListCardsContext(context.Background(), cc, customerID)
+}
+
+func ListCardsContext(ctx context.Context, cc ClientCredentials, customerID string) ([]VaultCard, error) {
+	query := url.Values{}
+	query.Add("customer_id", customerID)
+	res, err := NewRestClient(cc).WithContext(ctx).Get(endpointCards + "?" + query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		Cards []VaultCard `json:"cards"`
+	}
+	err = json.Unmarshal(res.Body, &list)
+	return list.Cards, err
+}
+
+func GetCard(cc ClientCredentials, cardID string) (VaultCard, error) {
+	return GetCardContext(context.Background(), cc, cardID)
+}
+
+func GetCardContext(ctx context.Context, cc ClientCredentials, cardID string) (VaultCard, error) {
+	res, err := NewRestClient(cc).WithContext(ctx).Get(endpointCards + "/" + url.PathEscape(cardID))
+	if err != nil {
+		return VaultCard{}, err
+	}
+
+	var vc VaultCard
+	err = json.Unmarshal(res.Body, &vc)
+	return vc, err
+}
+
+func RemoveCard(cc ClientCredentials, cardID string) error {
+	return RemoveCardContext(context.Background(), cc, cardID)
+}
+
+func RemoveCardContext(ctx context.Context, cc ClientCredentials, cardID string) error {
+	_, err := NewRestClient(cc).WithContext(ctx).Delete(endpointCards + "/" + url.PathEscape(cardID))
+	return err
+}
+
+type SavedCard struct {
+	CardID      string `json:"card_id"`
+	NumberToken string `json:"number_token"`
+}
+
+type VaultCard struct {
+	Card
+	CardID         string    `json:"card_id"`
+	LastFourDigits string    `json:"last_four_digits"`
+	CustomerID     string    `json:"customer_id"`
+	Status         string    `json:"status"`
+	UsedAt         time.Time `json:"used_at"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Credit retorna o pagamento com o cartão armazenado, sinalizando o uso da
+// credencial armazenada conforme o tipo de cobrança.
+func (vc VaultCard) Credit(t CredentialsOnFileType, originalTransactionID string) Credit {
+	initiator := InitiatorMerchant
+	if t == OneClick {
+		initiator = InitiatorCardholder
+	}
+	return Credit{
+		Card: vc.Card,
+		CredentialsOnFile: &CredentialsOnFile{
+			Type:          t,
+			Initiator:     initiator,
+			TransactionID: originalTransactionID,
+		},
+	}
+}
+
+func (vc VaultCard) Remove(cc ClientCredentials) error {
+	return vc.RemoveContext(context.Background(), cc)
+}
+
+func (vc VaultCard) RemoveContext(ctx context.Context, cc ClientCredentials) error {
+	return RemoveCardContext(ctx, cc, vc.CardID)
+}
+
+func (vc *VaultCard) UnmarshalJSON(data []byte) error {
+	type Alias VaultCard
+	aux := &struct {
+		*Alias
+		UsedAt    string `json:"used_at"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias: (*Alias)(vc),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	vc.Card.CustomerID = vc.CustomerID
+	vc.UsedAt, _ = time.Parse(time.RFC3339, aux.UsedAt)
+	vc.CreatedAt, _ = time.Parse(time.RFC3339, aux.CreatedAt)
+	vc.UpdatedAt, _ = time.Parse(time.RFC3339, aux.UpdatedAt)
+	return nil
+}
@@ -0,0 +1,149 @@
+package getnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	cardID     = "e8ad2ae4-9e3e-4532-998f-1a5a11e56e58"
+	customerID = "ea05ba48-d193-4eb8-a4e9-c9cae1e3e2aa"
+)
+
+func TestCardSave(t *testing.T) {
+	server := serverTestVault()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	card := Card{
+		NumberToken:     numberToken,
+		Brand:           Mastercard,
+		CardHolderName:  "JOAO DA SILVA",
+		ExpirationMonth: "12",
+		ExpirationYear:  "28",
+		CustomerID:      customerID,
+	}
+	sc, err := card.Save(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if sc.CardID != cardID {
+		t.Errorf("Expected '%s', got '%s'", cardID, sc.CardID)
+	}
+}
+
+func TestCardSaveCustomerIDRequired(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	card := Card{NumberToken: numberToken}
+	_, err := card.Save(credentials)
+	if err != errCustomerID {
+		t.Errorf("Expected '%s', got '%v'", errCustomerID, err)
+	}
+}
+
+func TestListCards(t *testing.T) {
+	server := serverTestVault()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	cards, err := ListCards(credentials, customerID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if len(cards) != 1 {
+		t.Fatalf("Expected 1 card, got %d", len(cards))
+	}
+
+	credit := Credit{Card: cards[0].Card}
+	if credit.Card.NumberToken != numberToken {
+		t.Errorf("Expected '%s', got '%s'", numberToken, credit.Card.NumberToken)
+	}
+	if credit.Card.CustomerID != customerID {
+		t.Errorf("Expected '%s', got '%s'", customerID, credit.Card.CustomerID)
+	}
+}
+
+func TestGetAndRemoveCard(t *testing.T) {
+	server := serverTestVault()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	vc, err := GetCard(credentials, cardID)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if vc.LastFourDigits != "0001" {
+		t.Errorf("Expected '%s', got '%s'", "0001", vc.LastFourDigits)
+	}
+
+	expected := "2017-03-19 16:30:30 +0000 UTC"
+	if vc.CreatedAt.String() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, vc.CreatedAt.String())
+	}
+
+	if err := vc.Remove(credentials); err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+}
+
+func serverTestVault() *httptest.Server {
+	card := fmt.Sprintf(`{
+"card_id": "%s",
+"last_four_digits": "0001",
+"expiration_month": "12",
+"expiration_year": "28",
+"brand": "Mastercard",
+"cardholder_name": "JOAO DA SILVA",
+"customer_id": "%s",
+"number_token": "%s",
+"used_at": "2017-03-19T16:30:30Z",
+"created_at": "2017-03-19T16:30:30Z",
+"updated_at": "2017-03-19T16:30:30Z",
+"status": "active"
+}`, cardID, customerID, numberToken)
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/cards":
+			var payload struct {
+				NumberToken string `json:"number_token"`
+				CustomerID  string `json:"customer_id"`
+			}
+			json.NewDecoder(req.Body).Decode(&payload)
+			if payload.NumberToken != numberToken || payload.CustomerID != customerID {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(rw, `{"card_id": "%s", "number_token": "%s"}`, cardID, numberToken)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/cards":
+			if req.URL.Query().Get("customer_id") != customerID {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+			fmt.Fprintf(rw, `{"cards": [%s]}`, card)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/cards/"+cardID:
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(card))
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/cards/"+cardID:
+			rw.WriteHeader(http.StatusNoContent)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
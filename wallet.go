@@ -0,0 +1,42 @@
+package getnet
+
+import (
+	"errors"
+)
+
+var errWalletData = errors.New("Obrigatório informar o payload cifrado da carteira digital ou o token de rede com o criptograma.")
+
+type WalletType string
+
+const (
+	// Carteira digital (wallet.type)
+	GooglePay  WalletType = "GOOGLE_PAY"
+	ApplePay   WalletType = "APPLE_PAY"
+	SamsungPay WalletType = "SAMSUNG_PAY"
+)
+
+type Wallet struct {
+	Type WalletType `json:"type"`
+
+	// Payload cifrado entregue pela carteira, descriptografado pela Getnet.
+	EncryptedPayload string `json:"encrypted_payload,omitempty"`
+
+	// Token de rede já descriptografado pelo lojista.
+	NetworkToken    string `json:"network_token,omitempty"`
+	Cryptogram      string `json:"cryptogram,omitempty"`
+	ECI             string `json:"eci,omitempty"`
+	ExpirationMonth string `json:"expiration_month,omitempty"`
+	ExpirationYear  string `json:"expiration_year,omitempty"`
+	CardHolderName  string `json:"cardholder_name,omitempty"`
+	Brand           Brand  `json:"brand,omitempty"`
+}
+
+func (w Wallet) validate() error {
+	if w.EncryptedPayload != "" {
+		return nil
+	}
+	if w.NetworkToken == "" || w.Cryptogram == "" {
+		return errWalletData
+	}
+	return nil
+}
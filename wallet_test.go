@@ -0,0 +1,88 @@
+package getnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaymentWallet(t *testing.T) {
+	server := serverTestPaymentWallet()
+	defer server.Close()
+
+	urlStaging = server.URL
+
+	credentials := fixtureCredentials()
+
+	p := Payment{
+		Amount: 1.23,
+		Credit: Credit{
+			Wallet: &Wallet{
+				Type:            GooglePay,
+				NetworkToken:    "4895370015293175",
+				Cryptogram:      "AgAAAAAABk4DWZ4C28yUQAAAAAA=",
+				ECI:             "05",
+				ExpirationMonth: "12",
+				ExpirationYear:  "28",
+			},
+		},
+	}
+	pr, err := p.Pay(credentials)
+	if err != nil {
+		t.Errorf("There should not be an error, error: %s", err)
+	}
+	if pr.Credit.WalletType != GooglePay {
+		t.Errorf("Expected '%s', got '%s'", GooglePay, pr.Credit.WalletType)
+	}
+}
+
+func TestPaymentWalletDataRequired(t *testing.T) {
+	credentials := fixtureCredentials()
+
+	p := Payment{
+		Amount: 1.23,
+		Credit: Credit{
+			Wallet: &Wallet{Type: ApplePay, NetworkToken: "4895370015293175"},
+		},
+	}
+	_, err := p.Pay(credentials)
+	if err != errWalletData {
+		t.Errorf("Expected '%s', got '%v'", errWalletData, err)
+	}
+}
+
+func serverTestPaymentWallet() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+
+		var p struct {
+			Credit map[string]json.RawMessage `json:"credit"`
+		}
+		json.NewDecoder(req.Body).Decode(&p)
+		if _, ok := p.Credit["card"]; ok {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var w Wallet
+		json.Unmarshal(p.Credit["wallet"], &w)
+		if w.Type != GooglePay || w.Cryptogram == "" {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		rw.WriteHeader(http.StatusCreated)
+		payload := `{
+"payment_id": "06f256c8-1bbf-42bf-93b4-ce2041bfb87e",
+"amount": 123,
+"currency": "BRL",
+"status": "APPROVED",
+"credit": {
+  "authorization_code": "000000099999",
+  "brand": "Visa",
+  "wallet_type": "GOOGLE_PAY",
+  "transaction_id": "1002217281190421"
+}
+}`
+		rw.Write([]byte(payload))
+	}))
+}